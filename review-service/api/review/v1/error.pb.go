@@ -25,9 +25,26 @@ type ErrorReason int32
 
 const (
 	// 为某个枚举单独设置错误码
-	ErrorReason_NEED_LOGIN     ErrorReason = 0   //NEED_LOGIN 对应401错误码
-	ErrorReason_DB_FAILED      ErrorReason = 1   //DB_FAILED 对应500错误码
-	ErrorReason_ORDER_REVIEWED ErrorReason = 100 //ORDER_REVIEWD 对应400错误码
+	ErrorReason_NEED_LOGIN                ErrorReason = 0   //NEED_LOGIN 对应401错误码
+	ErrorReason_DB_FAILED                 ErrorReason = 1   //DB_FAILED 对应500错误码
+	ErrorReason_ORDER_REVIEWED            ErrorReason = 100 //ORDER_REVIEWD 对应400错误码
+	ErrorReason_REVIEW_NOT_FOUND          ErrorReason = 101 //REVIEW_NOT_FOUND 评价不存在
+	ErrorReason_APPEAL_NOT_FOUND          ErrorReason = 102 //APPEAL_NOT_FOUND 申诉不存在
+	ErrorReason_INVALID_STATUS            ErrorReason = 103 //INVALID_STATUS 未定义的状态值
+	ErrorReason_ILLEGAL_REVIEW_TRANSITION ErrorReason = 104 //ILLEGAL_REVIEW_TRANSITION 评价状态不允许这样流转
+	ErrorReason_ILLEGAL_APPEAL_TRANSITION ErrorReason = 105 //ILLEGAL_APPEAL_TRANSITION 申诉状态不允许这样流转
+	ErrorReason_CONCURRENT_MODIFICATION   ErrorReason = 106 //CONCURRENT_MODIFICATION 记录已被他人修改(乐观锁冲突)
+	ErrorReason_INVALID_PARAM             ErrorReason = 107 //INVALID_PARAM 参数之间互相矛盾(例如范围的下限大于上限)
+	ErrorReason_PERMISSION_DENIED         ErrorReason = 108 //PERMISSION_DENIED 调用方的角色或身份无权进行该操作
+	ErrorReason_ALREADY_REPLIED           ErrorReason = 109 //ALREADY_REPLIED 商家已经回复过该评价
+	ErrorReason_ALREADY_APPENDED          ErrorReason = 110 //ALREADY_APPENDED 用户已经追评过该评价
+	ErrorReason_APPEND_WINDOW_EXPIRED     ErrorReason = 111 //APPEND_WINDOW_EXPIRED 已超过可以追评的期限
+	ErrorReason_APPEND_NOT_FOUND          ErrorReason = 112 //APPEND_NOT_FOUND 追评不存在
+	ErrorReason_MEDIA_NOT_FOUND           ErrorReason = 113 //MEDIA_NOT_FOUND 媒体不存在
+	ErrorReason_MEDIA_UPLOAD_EXPIRED      ErrorReason = 114 //MEDIA_UPLOAD_EXPIRED 上传地址已过期
+	ErrorReason_MEDIA_NOT_UPLOADED        ErrorReason = 115 //MEDIA_NOT_UPLOADED 文件还没有上传,或者还没有确认上传完成
+	ErrorReason_MEDIA_REJECTED            ErrorReason = 116 //MEDIA_REJECTED 文件的类型或大小不符合要求
+	ErrorReason_MEDIA_ALREADY_USED        ErrorReason = 117 //MEDIA_ALREADY_USED 媒体已经关联了其他评价
 )
 
 // Enum value maps for ErrorReason.
@@ -36,11 +53,45 @@ var (
 		0:   "NEED_LOGIN",
 		1:   "DB_FAILED",
 		100: "ORDER_REVIEWED",
+		101: "REVIEW_NOT_FOUND",
+		102: "APPEAL_NOT_FOUND",
+		103: "INVALID_STATUS",
+		104: "ILLEGAL_REVIEW_TRANSITION",
+		105: "ILLEGAL_APPEAL_TRANSITION",
+		106: "CONCURRENT_MODIFICATION",
+		107: "INVALID_PARAM",
+		108: "PERMISSION_DENIED",
+		109: "ALREADY_REPLIED",
+		110: "ALREADY_APPENDED",
+		111: "APPEND_WINDOW_EXPIRED",
+		112: "APPEND_NOT_FOUND",
+		113: "MEDIA_NOT_FOUND",
+		114: "MEDIA_UPLOAD_EXPIRED",
+		115: "MEDIA_NOT_UPLOADED",
+		116: "MEDIA_REJECTED",
+		117: "MEDIA_ALREADY_USED",
 	}
 	ErrorReason_value = map[string]int32{
-		"NEED_LOGIN":     0,
-		"DB_FAILED":      1,
-		"ORDER_REVIEWED": 100,
+		"NEED_LOGIN":                0,
+		"DB_FAILED":                 1,
+		"ORDER_REVIEWED":            100,
+		"REVIEW_NOT_FOUND":          101,
+		"APPEAL_NOT_FOUND":          102,
+		"INVALID_STATUS":            103,
+		"ILLEGAL_REVIEW_TRANSITION": 104,
+		"ILLEGAL_APPEAL_TRANSITION": 105,
+		"CONCURRENT_MODIFICATION":   106,
+		"INVALID_PARAM":             107,
+		"PERMISSION_DENIED":         108,
+		"ALREADY_REPLIED":           109,
+		"ALREADY_APPENDED":          110,
+		"APPEND_WINDOW_EXPIRED":     111,
+		"APPEND_NOT_FOUND":          112,
+		"MEDIA_NOT_FOUND":           113,
+		"MEDIA_UPLOAD_EXPIRED":      114,
+		"MEDIA_NOT_UPLOADED":        115,
+		"MEDIA_REJECTED":            116,
+		"MEDIA_ALREADY_USED":        117,
 	}
 )
 
@@ -77,17 +128,48 @@ var file_review_v1_error_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76,
 	0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x58, 0x0a, 0x0b, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x0a, 0x4e, 0x45,
-	0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x91, 0x03,
-	0x12, 0x13, 0x0a, 0x09, 0x44, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x1a,
-	0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x18, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52,
-	0x45, 0x56, 0x49, 0x45, 0x57, 0x45, 0x44, 0x10, 0x64, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x1a,
-	0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x32, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x50, 0x01, 0x5a, 0x1f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xd2, 0x04, 0x0a, 0x0b,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x0a, 0x4e,
+	0x45, 0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45, 0x91,
+	0x03, 0x12, 0x13, 0x0a, 0x09, 0x44, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x18, 0x0a, 0x0e, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x45, 0x44, 0x10, 0x64, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
+	0x12, 0x1a, 0x0a, 0x10, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x65, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x10,
+	0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
+	0x10, 0x66, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x18, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x67, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x23, 0x0a, 0x19, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x68, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x23, 0x0a, 0x19, 0x49, 0x4c, 0x4c, 0x45, 0x47,
+	0x41, 0x4c, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x41, 0x4c, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x69, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x21, 0x0a, 0x17,
+	0x43, 0x4f, 0x4e, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x6a, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12,
+	0x17, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x41, 0x4d,
+	0x10, 0x6b, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1b, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x6c, 0x1a,
+	0x04, 0xa8, 0x45, 0x93, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x6d, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03,
+	0x12, 0x1a, 0x0a, 0x10, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x50, 0x50, 0x45,
+	0x4e, 0x44, 0x45, 0x44, 0x10, 0x6e, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x12, 0x1f, 0x0a, 0x15,
+	0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x6f, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1a, 0x0a,
+	0x10, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x10, 0x70, 0x1a, 0x04, 0xa8, 0x45, 0x94, 0x03, 0x12, 0x19, 0x0a, 0x0f, 0x4d, 0x45, 0x44,
+	0x49, 0x41, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x71, 0x1a, 0x04,
+	0xa8, 0x45, 0x94, 0x03, 0x12, 0x1e, 0x0a, 0x14, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x55, 0x50,
+	0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x72, 0x1a, 0x04,
+	0xa8, 0x45, 0x90, 0x03, 0x12, 0x1c, 0x0a, 0x12, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x55, 0x50, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x73, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x18, 0x0a, 0x0e, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x74, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1c, 0x0a, 0x12,
+	0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x55, 0x53,
+	0x45, 0x44, 0x10, 0x75, 0x1a, 0x04, 0xa8, 0x45, 0x99, 0x03, 0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03,
+	0x42, 0x32, 0x0a, 0x0d, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x76,
+	0x31, 0x50, 0x01, 0x5a, 0x1f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x76,
+	0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -52,3 +52,241 @@ func IsOrderReviewed(err error) bool {
 func ErrorOrderReviewed(format string, args ...interface{}) *errors.Error {
 	return errors.New(400, ErrorReason_ORDER_REVIEWED.String(), fmt.Sprintf(format, args...))
 }
+
+// REVIEW_NOT_FOUND 评价不存在
+func IsReviewNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_REVIEW_NOT_FOUND.String() && e.Code == 404
+}
+
+// REVIEW_NOT_FOUND 评价不存在
+func ErrorReviewNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_REVIEW_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// APPEAL_NOT_FOUND 申诉不存在
+func IsAppealNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_APPEAL_NOT_FOUND.String() && e.Code == 404
+}
+
+// APPEAL_NOT_FOUND 申诉不存在
+func ErrorAppealNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_APPEAL_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// INVALID_STATUS 未定义的状态值
+func IsInvalidStatus(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_STATUS.String() && e.Code == 400
+}
+
+// INVALID_STATUS 未定义的状态值
+func ErrorInvalidStatus(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_STATUS.String(), fmt.Sprintf(format, args...))
+}
+
+// ILLEGAL_REVIEW_TRANSITION 评价状态不允许这样流转
+func IsIllegalReviewTransition(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ILLEGAL_REVIEW_TRANSITION.String() && e.Code == 409
+}
+
+// ILLEGAL_REVIEW_TRANSITION 评价状态不允许这样流转
+func ErrorIllegalReviewTransition(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_ILLEGAL_REVIEW_TRANSITION.String(), fmt.Sprintf(format, args...))
+}
+
+// ILLEGAL_APPEAL_TRANSITION 申诉状态不允许这样流转
+func IsIllegalAppealTransition(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ILLEGAL_APPEAL_TRANSITION.String() && e.Code == 409
+}
+
+// ILLEGAL_APPEAL_TRANSITION 申诉状态不允许这样流转
+func ErrorIllegalAppealTransition(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_ILLEGAL_APPEAL_TRANSITION.String(), fmt.Sprintf(format, args...))
+}
+
+// CONCURRENT_MODIFICATION 记录已被他人修改(乐观锁冲突)
+func IsConcurrentModification(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_CONCURRENT_MODIFICATION.String() && e.Code == 409
+}
+
+// CONCURRENT_MODIFICATION 记录已被他人修改(乐观锁冲突)
+func ErrorConcurrentModification(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_CONCURRENT_MODIFICATION.String(), fmt.Sprintf(format, args...))
+}
+
+// INVALID_PARAM 参数之间互相矛盾(例如范围的下限大于上限)
+func IsInvalidParam(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_INVALID_PARAM.String() && e.Code == 400
+}
+
+// INVALID_PARAM 参数之间互相矛盾(例如范围的下限大于上限)
+func ErrorInvalidParam(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_INVALID_PARAM.String(), fmt.Sprintf(format, args...))
+}
+
+// PERMISSION_DENIED 调用方的角色或身份无权进行该操作
+func IsPermissionDenied(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_PERMISSION_DENIED.String() && e.Code == 403
+}
+
+// PERMISSION_DENIED 调用方的角色或身份无权进行该操作
+func ErrorPermissionDenied(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_PERMISSION_DENIED.String(), fmt.Sprintf(format, args...))
+}
+
+// ALREADY_REPLIED 商家已经回复过该评价
+func IsAlreadyReplied(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ALREADY_REPLIED.String() && e.Code == 409
+}
+
+// ALREADY_REPLIED 商家已经回复过该评价
+func ErrorAlreadyReplied(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_ALREADY_REPLIED.String(), fmt.Sprintf(format, args...))
+}
+
+// ALREADY_APPENDED 用户已经追评过该评价
+func IsAlreadyAppended(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_ALREADY_APPENDED.String() && e.Code == 409
+}
+
+// ALREADY_APPENDED 用户已经追评过该评价
+func ErrorAlreadyAppended(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_ALREADY_APPENDED.String(), fmt.Sprintf(format, args...))
+}
+
+// APPEND_WINDOW_EXPIRED 已超过可以追评的期限
+func IsAppendWindowExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_APPEND_WINDOW_EXPIRED.String() && e.Code == 400
+}
+
+// APPEND_WINDOW_EXPIRED 已超过可以追评的期限
+func ErrorAppendWindowExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_APPEND_WINDOW_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
+// APPEND_NOT_FOUND 追评不存在
+func IsAppendNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_APPEND_NOT_FOUND.String() && e.Code == 404
+}
+
+// APPEND_NOT_FOUND 追评不存在
+func ErrorAppendNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_APPEND_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// MEDIA_NOT_FOUND 媒体不存在
+func IsMediaNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MEDIA_NOT_FOUND.String() && e.Code == 404
+}
+
+// MEDIA_NOT_FOUND 媒体不存在
+func ErrorMediaNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_MEDIA_NOT_FOUND.String(), fmt.Sprintf(format, args...))
+}
+
+// MEDIA_UPLOAD_EXPIRED 上传地址已过期
+func IsMediaUploadExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MEDIA_UPLOAD_EXPIRED.String() && e.Code == 400
+}
+
+// MEDIA_UPLOAD_EXPIRED 上传地址已过期
+func ErrorMediaUploadExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_MEDIA_UPLOAD_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
+// MEDIA_NOT_UPLOADED 文件还没有上传,或者还没有确认上传完成
+func IsMediaNotUploaded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MEDIA_NOT_UPLOADED.String() && e.Code == 400
+}
+
+// MEDIA_NOT_UPLOADED 文件还没有上传,或者还没有确认上传完成
+func ErrorMediaNotUploaded(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_MEDIA_NOT_UPLOADED.String(), fmt.Sprintf(format, args...))
+}
+
+// MEDIA_REJECTED 文件的类型或大小不符合要求
+func IsMediaRejected(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MEDIA_REJECTED.String() && e.Code == 400
+}
+
+// MEDIA_REJECTED 文件的类型或大小不符合要求
+func ErrorMediaRejected(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_MEDIA_REJECTED.String(), fmt.Sprintf(format, args...))
+}
+
+// MEDIA_ALREADY_USED 媒体已经关联了其他评价
+func IsMediaAlreadyUsed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MEDIA_ALREADY_USED.String() && e.Code == 409
+}
+
+// MEDIA_ALREADY_USED 媒体已经关联了其他评价
+func ErrorMediaAlreadyUsed(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_MEDIA_ALREADY_USED.String(), fmt.Sprintf(format, args...))
+}
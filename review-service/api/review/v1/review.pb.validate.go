@@ -35,32 +35,31 @@ var (
 	_ = sort.Sort
 )
 
-// Validate checks the field values on ListReviewByStoreIDRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListReviewByStoreIDRequest) Validate() error {
+// Validate checks the field values on Media with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Media) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReviewByStoreIDRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListReviewByStoreIDRequestMultiError, or nil if none found.
-func (m *ListReviewByStoreIDRequest) ValidateAll() error {
+// ValidateAll checks the field values on Media with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MediaMultiError, or nil if none found.
+func (m *Media) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReviewByStoreIDRequest) validate(all bool) error {
+func (m *Media) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetStoreID() <= 0 {
-		err := ListReviewByStoreIDRequestValidationError{
-			field:  "StoreID",
-			reason: "value must be greater than 0",
+	if _, ok := _Media_Type_NotInLookup[m.GetType()]; ok {
+		err := MediaValidationError{
+			field:  "Type",
+			reason: "value must not be in list [MEDIA_TYPE_UNSPECIFIED]",
 		}
 		if !all {
 			return err
@@ -68,10 +67,10 @@ func (m *ListReviewByStoreIDRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPage() <= 0 {
-		err := ListReviewByStoreIDRequestValidationError{
-			field:  "Page",
-			reason: "value must be greater than 0",
+	if _, ok := MediaType_name[int32(m.GetType())]; !ok {
+		err := MediaValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -79,10 +78,76 @@ func (m *ListReviewByStoreIDRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetSize() <= 0 {
-		err := ListReviewByStoreIDRequestValidationError{
+	if utf8.RuneCountInString(m.GetUrl()) > 512 {
+		err := MediaValidationError{
+			field:  "Url",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObjectKey()) > 256 {
+		err := MediaValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWidth() < 0 {
+		err := MediaValidationError{
+			field:  "Width",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHeight() < 0 {
+		err := MediaValidationError{
+			field:  "Height",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDuration() < 0 {
+		err := MediaValidationError{
+			field:  "Duration",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Media_ContentHash_Pattern.MatchString(m.GetContentHash()) {
+		err := MediaValidationError{
+			field:  "ContentHash",
+			reason: "value does not match regex pattern \"^([0-9a-f]{64})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() < 0 {
+		err := MediaValidationError{
 			field:  "Size",
-			reason: "value must be greater than 0",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -90,20 +155,21 @@ func (m *ListReviewByStoreIDRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ThumbnailUrl
+
 	if len(errors) > 0 {
-		return ListReviewByStoreIDRequestMultiError(errors)
+		return MediaMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReviewByStoreIDRequestMultiError is an error wrapping multiple
-// validation errors returned by ListReviewByStoreIDRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ListReviewByStoreIDRequestMultiError []error
+// MediaMultiError is an error wrapping multiple validation errors returned by
+// Media.ValidateAll() if the designated constraints aren't met.
+type MediaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReviewByStoreIDRequestMultiError) Error() string {
+func (m MediaMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -112,11 +178,11 @@ func (m ListReviewByStoreIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReviewByStoreIDRequestMultiError) AllErrors() []error { return m }
+func (m MediaMultiError) AllErrors() []error { return m }
 
-// ListReviewByStoreIDRequestValidationError is the validation error returned
-// by ListReviewByStoreIDRequest.Validate if the designated constraints aren't met.
-type ListReviewByStoreIDRequestValidationError struct {
+// MediaValidationError is the validation error returned by Media.Validate if
+// the designated constraints aren't met.
+type MediaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -124,24 +190,22 @@ type ListReviewByStoreIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReviewByStoreIDRequestValidationError) Field() string { return e.field }
+func (e MediaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReviewByStoreIDRequestValidationError) Reason() string { return e.reason }
+func (e MediaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReviewByStoreIDRequestValidationError) Cause() error { return e.cause }
+func (e MediaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReviewByStoreIDRequestValidationError) Key() bool { return e.key }
+func (e MediaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReviewByStoreIDRequestValidationError) ErrorName() string {
-	return "ListReviewByStoreIDRequestValidationError"
-}
+func (e MediaValidationError) ErrorName() string { return "MediaValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListReviewByStoreIDRequestValidationError) Error() string {
+func (e MediaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -153,14 +217,14 @@ func (e ListReviewByStoreIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReviewByStoreIDRequest.%s: %s%s",
+		"invalid %sMedia.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReviewByStoreIDRequestValidationError{}
+var _ error = MediaValidationError{}
 
 var _ interface {
 	Field() string
@@ -168,78 +232,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReviewByStoreIDRequestValidationError{}
+} = MediaValidationError{}
 
-// Validate checks the field values on ListReviewByStoreIDReply with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListReviewByStoreIDReply) Validate() error {
+var _Media_Type_NotInLookup = map[MediaType]struct{}{
+	0: {},
+}
+
+var _Media_ContentHash_Pattern = regexp.MustCompile("^([0-9a-f]{64})?$")
+
+// Validate checks the field values on ReviewStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewStats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReviewByStoreIDReply with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListReviewByStoreIDReplyMultiError, or nil if none found.
-func (m *ListReviewByStoreIDReply) ValidateAll() error {
+// ValidateAll checks the field values on ReviewStats with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewStatsMultiError, or
+// nil if none found.
+func (m *ReviewStats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReviewByStoreIDReply) validate(all bool) error {
+func (m *ReviewStats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetList() {
-		_, _ = idx, item
+	// no validation rules for Count
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListReviewByStoreIDReplyValidationError{
-						field:  fmt.Sprintf("List[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListReviewByStoreIDReplyValidationError{
-						field:  fmt.Sprintf("List[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListReviewByStoreIDReplyValidationError{
-					field:  fmt.Sprintf("List[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for AvgScore
 
-	}
+	// no validation rules for AvgServiceScore
+
+	// no validation rules for AvgExpressScore
+
+	// no validation rules for MediaRatio
+
+	// no validation rules for ReplyRatio
+
+	// no validation rules for GoodRate
 
 	if len(errors) > 0 {
-		return ListReviewByStoreIDReplyMultiError(errors)
+		return ReviewStatsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReviewByStoreIDReplyMultiError is an error wrapping multiple validation
-// errors returned by ListReviewByStoreIDReply.ValidateAll() if the designated
-// constraints aren't met.
-type ListReviewByStoreIDReplyMultiError []error
+// ReviewStatsMultiError is an error wrapping multiple validation errors
+// returned by ReviewStats.ValidateAll() if the designated constraints aren't met.
+type ReviewStatsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReviewByStoreIDReplyMultiError) Error() string {
+func (m ReviewStatsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -248,11 +297,11 @@ func (m ListReviewByStoreIDReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReviewByStoreIDReplyMultiError) AllErrors() []error { return m }
+func (m ReviewStatsMultiError) AllErrors() []error { return m }
 
-// ListReviewByStoreIDReplyValidationError is the validation error returned by
-// ListReviewByStoreIDReply.Validate if the designated constraints aren't met.
-type ListReviewByStoreIDReplyValidationError struct {
+// ReviewStatsValidationError is the validation error returned by
+// ReviewStats.Validate if the designated constraints aren't met.
+type ReviewStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -260,24 +309,22 @@ type ListReviewByStoreIDReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReviewByStoreIDReplyValidationError) Field() string { return e.field }
+func (e ReviewStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReviewByStoreIDReplyValidationError) Reason() string { return e.reason }
+func (e ReviewStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReviewByStoreIDReplyValidationError) Cause() error { return e.cause }
+func (e ReviewStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReviewByStoreIDReplyValidationError) Key() bool { return e.key }
+func (e ReviewStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReviewByStoreIDReplyValidationError) ErrorName() string {
-	return "ListReviewByStoreIDReplyValidationError"
-}
+func (e ReviewStatsValidationError) ErrorName() string { return "ReviewStatsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListReviewByStoreIDReplyValidationError) Error() string {
+func (e ReviewStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -289,14 +336,14 @@ func (e ListReviewByStoreIDReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReviewByStoreIDReply.%s: %s%s",
+		"invalid %sReviewStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReviewByStoreIDReplyValidationError{}
+var _ error = ReviewStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -304,54 +351,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReviewByStoreIDReplyValidationError{}
+} = ReviewStatsValidationError{}
 
-// Validate checks the field values on CreateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetStoreReviewStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateReviewRequest) Validate() error {
+func (m *GetStoreReviewStatsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetStoreReviewStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReviewRequestMultiError, or nil if none found.
-func (m *CreateReviewRequest) ValidateAll() error {
+// GetStoreReviewStatsRequestMultiError, or nil if none found.
+func (m *GetStoreReviewStatsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReviewRequest) validate(all bool) error {
+func (m *GetStoreReviewStatsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserID() <= 0 {
-		err := CreateReviewRequestValidationError{
-			field:  "UserID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetOrderID() <= 0 {
-		err := CreateReviewRequestValidationError{
-			field:  "OrderID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if m.GetStoreID() <= 0 {
-		err := CreateReviewRequestValidationError{
+		err := GetStoreReviewStatsRequestValidationError{
 			field:  "StoreID",
 			reason: "value must be greater than 0",
 		}
@@ -361,70 +386,20 @@ func (m *CreateReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := _CreateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
-		err := CreateReviewRequestValidationError{
-			field:  "Score",
-			reason: "value must be in list [1 2 3 4 5]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _CreateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
-		err := CreateReviewRequestValidationError{
-			field:  "ServiceScore",
-			reason: "value must be in list [1 2 3 4 5]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _CreateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
-		err := CreateReviewRequestValidationError{
-			field:  "ExpressScore",
-			reason: "value must be in list [1 2 3 4 5]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if l := utf8.RuneCountInString(m.GetContent()); l < 8 || l > 255 {
-		err := CreateReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 8 and 255 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for PicInfo
-
-	// no validation rules for VideoInfo
-
-	// no validation rules for Anonymous
-
 	if len(errors) > 0 {
-		return CreateReviewRequestMultiError(errors)
+		return GetStoreReviewStatsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateReviewRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CreateReviewRequestMultiError []error
+// GetStoreReviewStatsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetStoreReviewStatsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetStoreReviewStatsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReviewRequestMultiError) Error() string {
+func (m GetStoreReviewStatsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -433,11 +408,11 @@ func (m CreateReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+func (m GetStoreReviewStatsRequestMultiError) AllErrors() []error { return m }
 
-// CreateReviewRequestValidationError is the validation error returned by
-// CreateReviewRequest.Validate if the designated constraints aren't met.
-type CreateReviewRequestValidationError struct {
+// GetStoreReviewStatsRequestValidationError is the validation error returned
+// by GetStoreReviewStatsRequest.Validate if the designated constraints aren't met.
+type GetStoreReviewStatsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -445,24 +420,2942 @@ type CreateReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReviewRequestValidationError) Field() string { return e.field }
+func (e GetStoreReviewStatsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
+func (e GetStoreReviewStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreReviewStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreReviewStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreReviewStatsRequestValidationError) ErrorName() string {
+	return "GetStoreReviewStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStoreReviewStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreReviewStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreReviewStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreReviewStatsRequestValidationError{}
+
+// Validate checks the field values on GetStoreReviewStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStoreReviewStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStoreReviewStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStoreReviewStatsReplyMultiError, or nil if none found.
+func (m *GetStoreReviewStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStoreReviewStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetStoreReviewStatsReplyValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetStoreReviewStatsReplyValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetStoreReviewStatsReplyValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetStoreReviewStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStoreReviewStatsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetStoreReviewStatsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetStoreReviewStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStoreReviewStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStoreReviewStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetStoreReviewStatsReplyValidationError is the validation error returned by
+// GetStoreReviewStatsReply.Validate if the designated constraints aren't met.
+type GetStoreReviewStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStoreReviewStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStoreReviewStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreReviewStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreReviewStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreReviewStatsReplyValidationError) ErrorName() string {
+	return "GetStoreReviewStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStoreReviewStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreReviewStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreReviewStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreReviewStatsReplyValidationError{}
+
+// Validate checks the field values on GetSpuReviewStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSpuReviewStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSpuReviewStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSpuReviewStatsRequestMultiError, or nil if none found.
+func (m *GetSpuReviewStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSpuReviewStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSpuID() <= 0 {
+		err := GetSpuReviewStatsRequestValidationError{
+			field:  "SpuID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetSpuReviewStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSpuReviewStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSpuReviewStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSpuReviewStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSpuReviewStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSpuReviewStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetSpuReviewStatsRequestValidationError is the validation error returned by
+// GetSpuReviewStatsRequest.Validate if the designated constraints aren't met.
+type GetSpuReviewStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSpuReviewStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSpuReviewStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSpuReviewStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSpuReviewStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSpuReviewStatsRequestValidationError) ErrorName() string {
+	return "GetSpuReviewStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSpuReviewStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSpuReviewStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSpuReviewStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSpuReviewStatsRequestValidationError{}
+
+// Validate checks the field values on GetSpuReviewStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSpuReviewStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSpuReviewStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSpuReviewStatsReplyMultiError, or nil if none found.
+func (m *GetSpuReviewStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSpuReviewStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetSpuReviewStatsReplyValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetSpuReviewStatsReplyValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetSpuReviewStatsReplyValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetSpuReviewStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSpuReviewStatsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetSpuReviewStatsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetSpuReviewStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSpuReviewStatsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSpuReviewStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetSpuReviewStatsReplyValidationError is the validation error returned by
+// GetSpuReviewStatsReply.Validate if the designated constraints aren't met.
+type GetSpuReviewStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSpuReviewStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSpuReviewStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSpuReviewStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSpuReviewStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSpuReviewStatsReplyValidationError) ErrorName() string {
+	return "GetSpuReviewStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSpuReviewStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSpuReviewStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSpuReviewStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSpuReviewStatsReplyValidationError{}
+
+// Validate checks the field values on SearchReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchReviewsRequestMultiError, or nil if none found.
+func (m *SearchReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetKeyword()) > 64 {
+		err := SearchReviewsRequestValidationError{
+			field:  "Keyword",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := SearchSort_name[int32(m.GetSort())]; !ok {
+		err := SearchReviewsRequestValidationError{
+			field:  "Sort",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := SearchReviewsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetSize(); val <= 0 || val > 50 {
+		err := SearchReviewsRequestValidationError{
+			field:  "Size",
+			reason: "value must be inside range (0, 50]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Raw
+
+	// no validation rules for PageToken
+
+	if m.StoreID != nil {
+
+		if m.GetStoreID() <= 0 {
+			err := SearchReviewsRequestValidationError{
+				field:  "StoreID",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.SpuID != nil {
+
+		if m.GetSpuID() <= 0 {
+			err := SearchReviewsRequestValidationError{
+				field:  "SpuID",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.SkuID != nil {
+
+		if m.GetSkuID() <= 0 {
+			err := SearchReviewsRequestValidationError{
+				field:  "SkuID",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MinScore != nil {
+
+		if _, ok := _SearchReviewsRequest_MinScore_InLookup[m.GetMinScore()]; !ok {
+			err := SearchReviewsRequestValidationError{
+				field:  "MinScore",
+				reason: "value must be in list [1 2 3 4 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.MaxScore != nil {
+
+		if _, ok := _SearchReviewsRequest_MaxScore_InLookup[m.GetMaxScore()]; !ok {
+			err := SearchReviewsRequestValidationError{
+				field:  "MaxScore",
+				reason: "value must be in list [1 2 3 4 5]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.HasMedia != nil {
+		// no validation rules for HasMedia
+	}
+
+	if m.HasReply != nil {
+		// no validation rules for HasReply
+	}
+
+	if m.Status != nil {
+
+		if _, ok := ReviewStatus_name[int32(m.GetStatus())]; !ok {
+			err := SearchReviewsRequestValidationError{
+				field:  "Status",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.StartTime != nil {
+
+		if m.GetStartTime() < 0 {
+			err := SearchReviewsRequestValidationError{
+				field:  "StartTime",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.EndTime != nil {
+
+		if m.GetEndTime() < 0 {
+			err := SearchReviewsRequestValidationError{
+				field:  "EndTime",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Anonymous != nil {
+		// no validation rules for Anonymous
+	}
+
+	if len(errors) > 0 {
+		return SearchReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReviewsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReviewsRequestMultiError) AllErrors() []error { return m }
+
+// SearchReviewsRequestValidationError is the validation error returned by
+// SearchReviewsRequest.Validate if the designated constraints aren't met.
+type SearchReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReviewsRequestValidationError) ErrorName() string {
+	return "SearchReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReviewsRequestValidationError{}
+
+var _SearchReviewsRequest_MinScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _SearchReviewsRequest_MaxScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on SearchReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchReviewsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReviewsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchReviewsReplyMultiError, or nil if none found.
+func (m *SearchReviewsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReviewsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchReviewsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchReviewsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return SearchReviewsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReviewsReplyMultiError is an error wrapping multiple validation errors
+// returned by SearchReviewsReply.ValidateAll() if the designated constraints
+// aren't met.
+type SearchReviewsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReviewsReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReviewsReplyMultiError) AllErrors() []error { return m }
+
+// SearchReviewsReplyValidationError is the validation error returned by
+// SearchReviewsReply.Validate if the designated constraints aren't met.
+type SearchReviewsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReviewsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReviewsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReviewsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReviewsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReviewsReplyValidationError) ErrorName() string {
+	return "SearchReviewsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchReviewsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReviewsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReviewsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReviewsReplyValidationError{}
+
+// Validate checks the field values on SearchReviewHit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchReviewHit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchReviewHit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchReviewHitMultiError, or nil if none found.
+func (m *SearchReviewHit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchReviewHit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReview()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchReviewHitValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchReviewHitValidationError{
+					field:  "Review",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReview()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchReviewHitValidationError{
+				field:  "Review",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SearchReviewHitMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchReviewHitMultiError is an error wrapping multiple validation errors
+// returned by SearchReviewHit.ValidateAll() if the designated constraints
+// aren't met.
+type SearchReviewHitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchReviewHitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchReviewHitMultiError) AllErrors() []error { return m }
+
+// SearchReviewHitValidationError is the validation error returned by
+// SearchReviewHit.Validate if the designated constraints aren't met.
+type SearchReviewHitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchReviewHitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchReviewHitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchReviewHitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchReviewHitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchReviewHitValidationError) ErrorName() string { return "SearchReviewHitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SearchReviewHitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchReviewHit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchReviewHitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchReviewHitValidationError{}
+
+// Validate checks the field values on ListReviewByStoreIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByStoreIDRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewByStoreIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewByStoreIDRequestMultiError, or nil if none found.
+func (m *ListReviewByStoreIDRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewByStoreIDRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStoreID() <= 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPage() < 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSize() <= 0 {
+		err := ListReviewByStoreIDRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for Raw
+
+	if len(errors) > 0 {
+		return ListReviewByStoreIDRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewByStoreIDRequestMultiError is an error wrapping multiple
+// validation errors returned by ListReviewByStoreIDRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListReviewByStoreIDRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewByStoreIDRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewByStoreIDRequestMultiError) AllErrors() []error { return m }
+
+// ListReviewByStoreIDRequestValidationError is the validation error returned
+// by ListReviewByStoreIDRequest.Validate if the designated constraints aren't met.
+type ListReviewByStoreIDRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewByStoreIDRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewByStoreIDRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewByStoreIDRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewByStoreIDRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewByStoreIDRequestValidationError) ErrorName() string {
+	return "ListReviewByStoreIDRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewByStoreIDRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewByStoreIDRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewByStoreIDRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewByStoreIDRequestValidationError{}
+
+// Validate checks the field values on ListReviewByStoreIDReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByStoreIDReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReviewByStoreIDReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReviewByStoreIDReplyMultiError, or nil if none found.
+func (m *ListReviewByStoreIDReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReviewByStoreIDReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewByStoreIDReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewByStoreIDReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewByStoreIDReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListReviewByStoreIDReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReviewByStoreIDReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByStoreIDReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewByStoreIDReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReviewByStoreIDReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReviewByStoreIDReplyMultiError) AllErrors() []error { return m }
+
+// ListReviewByStoreIDReplyValidationError is the validation error returned by
+// ListReviewByStoreIDReply.Validate if the designated constraints aren't met.
+type ListReviewByStoreIDReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReviewByStoreIDReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReviewByStoreIDReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReviewByStoreIDReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReviewByStoreIDReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReviewByStoreIDReplyValidationError) ErrorName() string {
+	return "ListReviewByStoreIDReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReviewByStoreIDReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReviewByStoreIDReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReviewByStoreIDReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReviewByStoreIDReplyValidationError{}
+
+// Validate checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewRequestMultiError, or nil if none found.
+func (m *CreateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	if m.GetOrderID() <= 0 {
+		err := CreateReviewRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStoreID() <= 0 {
+		err := CreateReviewRequestValidationError{
+			field:  "StoreID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "Score",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "ServiceScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := CreateReviewRequestValidationError{
+			field:  "ExpressScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 8 || l > 255 {
+		err := CreateReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 8 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Anonymous
+
+	if len(m.GetMedia()) > 10 {
+		err := CreateReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewRequestMultiError) AllErrors() []error { return m }
+
+// CreateReviewRequestValidationError is the validation error returned by
+// CreateReviewRequest.Validate if the designated constraints aren't met.
+type CreateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
 func (e CreateReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+func (e CreateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewRequestValidationError) ErrorName() string {
+	return "CreateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewRequestValidationError{}
+
+var _CreateReviewRequest_Score_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _CreateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _CreateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on CreateReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReviewReplyMultiError, or nil if none found.
+func (m *CreateReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	if len(errors) > 0 {
+		return CreateReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by CreateReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type CreateReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReviewReplyMultiError) AllErrors() []error { return m }
+
+// CreateReviewReplyValidationError is the validation error returned by
+// CreateReviewReply.Validate if the designated constraints aren't met.
+type CreateReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReviewReplyValidationError) ErrorName() string {
+	return "CreateReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReviewReplyValidationError{}
+
+// Validate checks the field values on GetReviewRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReviewRequestMultiError, or nil if none found.
+func (m *GetReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := GetReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Raw
+
+	if len(errors) > 0 {
+		return GetReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReviewRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewRequestMultiError) AllErrors() []error { return m }
+
+// GetReviewRequestValidationError is the validation error returned by
+// GetReviewRequest.Validate if the designated constraints aren't met.
+type GetReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewRequestValidationError) ErrorName() string { return "GetReviewRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewRequestValidationError{}
+
+// Validate checks the field values on GetReviewReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetReviewReplyMultiError,
+// or nil if none found.
+func (m *GetReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReviewReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReviewReplyValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReviewReplyValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by GetReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type GetReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReviewReplyMultiError) AllErrors() []error { return m }
+
+// GetReviewReplyValidationError is the validation error returned by
+// GetReviewReply.Validate if the designated constraints aren't met.
+type GetReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReviewReplyValidationError) ErrorName() string { return "GetReviewReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReviewReplyValidationError{}
+
+// Validate checks the field values on ReviewInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewInfoMultiError, or
+// nil if none found.
+func (m *ReviewInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for UserID
+
+	// no validation rules for OrderID
+
+	// no validation rules for Score
+
+	// no validation rules for ServiceScore
+
+	// no validation rules for ExpressScore
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetAppend()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReviewInfoValidationError{
+					field:  "Append",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReviewInfoValidationError{
+					field:  "Append",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAppend()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReviewInfoValidationError{
+				field:  "Append",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Anonymous
+
+	// no validation rules for Pseudonym
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReviewInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReviewInfoValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReviewInfoValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReviewInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewInfoMultiError is an error wrapping multiple validation errors
+// returned by ReviewInfo.ValidateAll() if the designated constraints aren't met.
+type ReviewInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewInfoMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewInfoMultiError) AllErrors() []error { return m }
+
+// ReviewInfoValidationError is the validation error returned by
+// ReviewInfo.Validate if the designated constraints aren't met.
+type ReviewInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewInfoValidationError) ErrorName() string { return "ReviewInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewInfoValidationError{}
+
+// Validate checks the field values on ReviewAppend with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewAppend) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewAppend with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewAppendMultiError, or
+// nil if none found.
+func (m *ReviewAppend) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewAppend) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppendID
+
+	// no validation rules for Content
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Status
+
+	// no validation rules for CreateAt
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReviewAppendValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReviewAppendValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReviewAppendValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReviewAppendMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewAppendMultiError is an error wrapping multiple validation errors
+// returned by ReviewAppend.ValidateAll() if the designated constraints aren't met.
+type ReviewAppendMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewAppendMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewAppendMultiError) AllErrors() []error { return m }
+
+// ReviewAppendValidationError is the validation error returned by
+// ReviewAppend.Validate if the designated constraints aren't met.
+type ReviewAppendValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewAppendValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewAppendValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewAppendValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewAppendValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewAppendValidationError) ErrorName() string { return "ReviewAppendValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewAppendValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewAppend.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewAppendValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewAppendValidationError{}
+
+// Validate checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewRequestMultiError, or nil if none found.
+func (m *UpdateReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := UpdateReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_Score_InLookup[m.GetScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "Score",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_ServiceScore_InLookup[m.GetServiceScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "ServiceScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _UpdateReviewRequest_ExpressScore_InLookup[m.GetExpressScore()]; !ok {
+		err := UpdateReviewRequestValidationError{
+			field:  "ExpressScore",
+			reason: "value must be in list [1 2 3 4 5]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 8 || l > 255 {
+		err := UpdateReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 8 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	// no validation rules for Anonymous
+
+	if len(m.GetMedia()) > 10 {
+		err := UpdateReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if len(errors) > 0 {
+		return UpdateReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReviewRequestValidationError is the validation error returned by
+// UpdateReviewRequest.Validate if the designated constraints aren't met.
+type UpdateReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewRequestValidationError) ErrorName() string {
+	return "UpdateReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewRequestValidationError{}
+
+var _UpdateReviewRequest_Score_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _UpdateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+var _UpdateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
+	1: {},
+	2: {},
+	3: {},
+	4: {},
+	5: {},
+}
+
+// Validate checks the field values on UpdateReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReviewReplyMultiError, or nil if none found.
+func (m *UpdateReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReviewID
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return UpdateReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReviewReplyMultiError) AllErrors() []error { return m }
+
+// UpdateReviewReplyValidationError is the validation error returned by
+// UpdateReviewReply.Validate if the designated constraints aren't met.
+type UpdateReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReviewReplyValidationError) ErrorName() string {
+	return "UpdateReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReviewReplyValidationError{}
+
+// Validate checks the field values on AppendReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AppendReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppendReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppendReviewRequestMultiError, or nil if none found.
+func (m *AppendReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppendReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := AppendReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 8 || l > 255 {
+		err := AppendReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 8 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(m.GetMedia()) > 10 {
+		err := AppendReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppendReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppendReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppendReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AppendReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppendReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by AppendReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AppendReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppendReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppendReviewRequestMultiError) AllErrors() []error { return m }
+
+// AppendReviewRequestValidationError is the validation error returned by
+// AppendReviewRequest.Validate if the designated constraints aren't met.
+type AppendReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppendReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppendReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppendReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppendReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppendReviewRequestValidationError) ErrorName() string {
+	return "AppendReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppendReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppendReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppendReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppendReviewRequestValidationError{}
+
+// Validate checks the field values on AppendReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppendReviewReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppendReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppendReviewReplyMultiError, or nil if none found.
+func (m *AppendReviewReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppendReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppendID
+
+	if len(errors) > 0 {
+		return AppendReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppendReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by AppendReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type AppendReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppendReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppendReviewReplyMultiError) AllErrors() []error { return m }
+
+// AppendReviewReplyValidationError is the validation error returned by
+// AppendReviewReply.Validate if the designated constraints aren't met.
+type AppendReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppendReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppendReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppendReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppendReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppendReviewReplyValidationError) ErrorName() string {
+	return "AppendReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppendReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppendReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppendReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppendReviewReplyValidationError{}
+
+// Validate checks the field values on AuditAppendReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditAppendReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditAppendReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditAppendReviewRequestMultiError, or nil if none found.
+func (m *AuditAppendReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditAppendReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAppendID() <= 0 {
+		err := AuditAppendReviewRequestValidationError{
+			field:  "AppendID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AuditAppendReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := AuditAppendReviewRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [REVIEW_STATUS_APPROVED REVIEW_STATUS_REJECTED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ReviewStatus_name[int32(m.GetStatus())]; !ok {
+		err := AuditAppendReviewRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := AuditAppendReviewRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.OpRemarks != nil {
+		// no validation rules for OpRemarks
+	}
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
+
+	if len(errors) > 0 {
+		return AuditAppendReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditAppendReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by AuditAppendReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuditAppendReviewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditAppendReviewRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditAppendReviewRequestMultiError) AllErrors() []error { return m }
+
+// AuditAppendReviewRequestValidationError is the validation error returned by
+// AuditAppendReviewRequest.Validate if the designated constraints aren't met.
+type AuditAppendReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditAppendReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditAppendReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditAppendReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditAppendReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReviewRequestValidationError) ErrorName() string {
-	return "CreateReviewRequestValidationError"
+func (e AuditAppendReviewRequestValidationError) ErrorName() string {
+	return "AuditAppendReviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReviewRequestValidationError) Error() string {
+func (e AuditAppendReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -474,14 +3367,14 @@ func (e CreateReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReviewRequest.%s: %s%s",
+		"invalid %sAuditAppendReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReviewRequestValidationError{}
+var _ error = AuditAppendReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -489,70 +3382,209 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReviewRequestValidationError{}
+} = AuditAppendReviewRequestValidationError{}
 
-var _CreateReviewRequest_Score_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+var _AuditAppendReviewRequest_Status_InLookup = map[ReviewStatus]struct{}{
+	20: {},
+	30: {},
 }
 
-var _CreateReviewRequest_ServiceScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+// Validate checks the field values on AuditAppendReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditAppendReviewReply) Validate() error {
+	return m.validate(false)
 }
 
-var _CreateReviewRequest_ExpressScore_InLookup = map[int32]struct{}{
-	1: {},
-	2: {},
-	3: {},
-	4: {},
-	5: {},
+// ValidateAll checks the field values on AuditAppendReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditAppendReviewReplyMultiError, or nil if none found.
+func (m *AuditAppendReviewReply) ValidateAll() error {
+	return m.validate(true)
 }
 
-// Validate checks the field values on CreateReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateReviewReply) Validate() error {
+func (m *AuditAppendReviewReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppendID
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return AuditAppendReviewReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditAppendReviewReplyMultiError is an error wrapping multiple validation
+// errors returned by AuditAppendReviewReply.ValidateAll() if the designated
+// constraints aren't met.
+type AuditAppendReviewReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditAppendReviewReplyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditAppendReviewReplyMultiError) AllErrors() []error { return m }
+
+// AuditAppendReviewReplyValidationError is the validation error returned by
+// AuditAppendReviewReply.Validate if the designated constraints aren't met.
+type AuditAppendReviewReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditAppendReviewReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditAppendReviewReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditAppendReviewReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditAppendReviewReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditAppendReviewReplyValidationError) ErrorName() string {
+	return "AuditAppendReviewReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditAppendReviewReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditAppendReviewReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditAppendReviewReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditAppendReviewReplyValidationError{}
+
+// Validate checks the field values on AuditReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateReviewReply with the rules
+// ValidateAll checks the field values on AuditReviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateReviewReplyMultiError, or nil if none found.
-func (m *CreateReviewReply) ValidateAll() error {
+// AuditReviewRequestMultiError, or nil if none found.
+func (m *AuditReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateReviewReply) validate(all bool) error {
+func (m *AuditReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
+	var errors []error
+
+	if m.GetReviewID() <= 0 {
+		err := AuditReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AuditReviewRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := AuditReviewRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [REVIEW_STATUS_APPROVED REVIEW_STATUS_REJECTED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ReviewStatus_name[int32(m.GetStatus())]; !ok {
+		err := AuditReviewRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OpUser
+
+	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
+		err := AuditReviewRequestValidationError{
+			field:  "OpReason",
+			reason: "value length must be at least 2 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.OpRemarks != nil {
+		// no validation rules for OpRemarks
+	}
 
-	// no validation rules for ReviewID
+	if m.Version != nil {
+		// no validation rules for Version
+	}
 
 	if len(errors) > 0 {
-		return CreateReviewReplyMultiError(errors)
+		return AuditReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by CreateReviewReply.ValidateAll() if the designated constraints
+// AuditReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by AuditReviewRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CreateReviewReplyMultiError []error
+type AuditReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateReviewReplyMultiError) Error() string {
+func (m AuditReviewRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -561,11 +3593,11 @@ func (m CreateReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateReviewReplyMultiError) AllErrors() []error { return m }
+func (m AuditReviewRequestMultiError) AllErrors() []error { return m }
 
-// CreateReviewReplyValidationError is the validation error returned by
-// CreateReviewReply.Validate if the designated constraints aren't met.
-type CreateReviewReplyValidationError struct {
+// AuditReviewRequestValidationError is the validation error returned by
+// AuditReviewRequest.Validate if the designated constraints aren't met.
+type AuditReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -573,24 +3605,24 @@ type CreateReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateReviewReplyValidationError) Field() string { return e.field }
+func (e AuditReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateReviewReplyValidationError) Reason() string { return e.reason }
+func (e AuditReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateReviewReplyValidationError) Cause() error { return e.cause }
+func (e AuditReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateReviewReplyValidationError) Key() bool { return e.key }
+func (e AuditReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateReviewReplyValidationError) ErrorName() string {
-	return "CreateReviewReplyValidationError"
+func (e AuditReviewRequestValidationError) ErrorName() string {
+	return "AuditReviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateReviewReplyValidationError) Error() string {
+func (e AuditReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -602,14 +3634,14 @@ func (e CreateReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateReviewReply.%s: %s%s",
+		"invalid %sAuditReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateReviewReplyValidationError{}
+var _ error = AuditReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -617,55 +3649,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateReviewReplyValidationError{}
+} = AuditReviewRequestValidationError{}
 
-// Validate checks the field values on GetReviewRequest with the rules defined
+var _AuditReviewRequest_Status_InLookup = map[ReviewStatus]struct{}{
+	20: {},
+	30: {},
+}
+
+// Validate checks the field values on AuditReviewReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetReviewRequest) Validate() error {
+func (m *AuditReviewReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReviewRequest with the rules
+// ValidateAll checks the field values on AuditReviewReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReviewRequestMultiError, or nil if none found.
-func (m *GetReviewRequest) ValidateAll() error {
+// AuditReviewReplyMultiError, or nil if none found.
+func (m *AuditReviewReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReviewRequest) validate(all bool) error {
+func (m *AuditReviewReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := GetReviewRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ReviewID
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return GetReviewRequestMultiError(errors)
+		return AuditReviewReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by GetReviewRequest.ValidateAll() if the designated constraints
+// AuditReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by AuditReviewReply.ValidateAll() if the designated constraints
 // aren't met.
-type GetReviewRequestMultiError []error
+type AuditReviewReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReviewRequestMultiError) Error() string {
+func (m AuditReviewReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -674,11 +3704,11 @@ func (m GetReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReviewRequestMultiError) AllErrors() []error { return m }
+func (m AuditReviewReplyMultiError) AllErrors() []error { return m }
 
-// GetReviewRequestValidationError is the validation error returned by
-// GetReviewRequest.Validate if the designated constraints aren't met.
-type GetReviewRequestValidationError struct {
+// AuditReviewReplyValidationError is the validation error returned by
+// AuditReviewReply.Validate if the designated constraints aren't met.
+type AuditReviewReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -686,22 +3716,22 @@ type GetReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReviewRequestValidationError) Field() string { return e.field }
+func (e AuditReviewReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReviewRequestValidationError) Reason() string { return e.reason }
+func (e AuditReviewReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReviewRequestValidationError) Cause() error { return e.cause }
+func (e AuditReviewReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReviewRequestValidationError) Key() bool { return e.key }
+func (e AuditReviewReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReviewRequestValidationError) ErrorName() string { return "GetReviewRequestValidationError" }
+func (e AuditReviewReplyValidationError) ErrorName() string { return "AuditReviewReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetReviewRequestValidationError) Error() string {
+func (e AuditReviewReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -713,14 +3743,14 @@ func (e GetReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReviewRequest.%s: %s%s",
+		"invalid %sAuditReviewReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReviewRequestValidationError{}
+var _ error = AuditReviewReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -728,73 +3758,117 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReviewRequestValidationError{}
+} = AuditReviewReplyValidationError{}
 
-// Validate checks the field values on GetReviewReply with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *GetReviewReply) Validate() error {
+// Validate checks the field values on ReplyReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplyReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetReviewReplyMultiError,
-// or nil if none found.
-func (m *GetReviewReply) ValidateAll() error {
+// ValidateAll checks the field values on ReplyReviewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyReviewRequestMultiError, or nil if none found.
+func (m *ReplyReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReviewReply) validate(all bool) error {
+func (m *ReplyReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetData()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetReviewReplyValidationError{
-					field:  "Data",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if m.GetReviewID() <= 0 {
+		err := ReplyReviewRequestValidationError{
+			field:  "ReviewID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for StoreID
+
+	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
+		err := ReplyReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 2 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(m.GetMedia()) > 10 {
+		err := ReplyReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplyReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplyReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GetReviewReplyValidationError{
-					field:  "Data",
+				return ReplyReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetReviewReplyValidationError{
-				field:  "Data",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return GetReviewReplyMultiError(errors)
+		return ReplyReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by GetReviewReply.ValidateAll() if the designated constraints
+// ReplyReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by ReplyReviewRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetReviewReplyMultiError []error
+type ReplyReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReviewReplyMultiError) Error() string {
+func (m ReplyReviewRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -803,11 +3877,11 @@ func (m GetReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReviewReplyMultiError) AllErrors() []error { return m }
+func (m ReplyReviewRequestMultiError) AllErrors() []error { return m }
 
-// GetReviewReplyValidationError is the validation error returned by
-// GetReviewReply.Validate if the designated constraints aren't met.
-type GetReviewReplyValidationError struct {
+// ReplyReviewRequestValidationError is the validation error returned by
+// ReplyReviewRequest.Validate if the designated constraints aren't met.
+type ReplyReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -815,22 +3889,24 @@ type GetReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReviewReplyValidationError) Field() string { return e.field }
+func (e ReplyReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReviewReplyValidationError) Reason() string { return e.reason }
+func (e ReplyReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReviewReplyValidationError) Cause() error { return e.cause }
+func (e ReplyReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReviewReplyValidationError) Key() bool { return e.key }
+func (e ReplyReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReviewReplyValidationError) ErrorName() string { return "GetReviewReplyValidationError" }
+func (e ReplyReviewRequestValidationError) ErrorName() string {
+	return "ReplyReviewRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetReviewReplyValidationError) Error() string {
+func (e ReplyReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -842,78 +3918,61 @@ func (e GetReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReviewReply.%s: %s%s",
+		"invalid %sReplyReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReviewReplyValidationError{}
+var _ error = ReplyReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
 	Reason() string
 	Key() bool
 	Cause() error
-	ErrorName() string
-} = GetReviewReplyValidationError{}
-
-// Validate checks the field values on ReviewInfo with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ReviewInfo) Validate() error {
+	ErrorName() string
+} = ReplyReviewRequestValidationError{}
+
+// Validate checks the field values on ReplyReviewReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReplyReviewReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReviewInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ReviewInfoMultiError, or
-// nil if none found.
-func (m *ReviewInfo) ValidateAll() error {
+// ValidateAll checks the field values on ReplyReviewReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplyReviewReplyMultiError, or nil if none found.
+func (m *ReplyReviewReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReviewInfo) validate(all bool) error {
+func (m *ReplyReviewReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReviewID
-
-	// no validation rules for UserID
-
-	// no validation rules for OrderID
-
-	// no validation rules for Score
-
-	// no validation rules for ServiceScore
-
-	// no validation rules for ExpressScore
-
-	// no validation rules for Content
-
-	// no validation rules for PicInfo
-
-	// no validation rules for VideoInfo
-
-	// no validation rules for Status
+	// no validation rules for ReplyID
 
 	if len(errors) > 0 {
-		return ReviewInfoMultiError(errors)
+		return ReplyReviewReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReviewInfoMultiError is an error wrapping multiple validation errors
-// returned by ReviewInfo.ValidateAll() if the designated constraints aren't met.
-type ReviewInfoMultiError []error
+// ReplyReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by ReplyReviewReply.ValidateAll() if the designated constraints
+// aren't met.
+type ReplyReviewReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReviewInfoMultiError) Error() string {
+func (m ReplyReviewReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -922,11 +3981,11 @@ func (m ReviewInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReviewInfoMultiError) AllErrors() []error { return m }
+func (m ReplyReviewReplyMultiError) AllErrors() []error { return m }
 
-// ReviewInfoValidationError is the validation error returned by
-// ReviewInfo.Validate if the designated constraints aren't met.
-type ReviewInfoValidationError struct {
+// ReplyReviewReplyValidationError is the validation error returned by
+// ReplyReviewReply.Validate if the designated constraints aren't met.
+type ReplyReviewReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -934,22 +3993,22 @@ type ReviewInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReviewInfoValidationError) Field() string { return e.field }
+func (e ReplyReviewReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReviewInfoValidationError) Reason() string { return e.reason }
+func (e ReplyReviewReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReviewInfoValidationError) Cause() error { return e.cause }
+func (e ReplyReviewReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReviewInfoValidationError) Key() bool { return e.key }
+func (e ReplyReviewReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReviewInfoValidationError) ErrorName() string { return "ReviewInfoValidationError" }
+func (e ReplyReviewReplyValidationError) ErrorName() string { return "ReplyReviewReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReviewInfoValidationError) Error() string {
+func (e ReplyReviewReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -961,14 +4020,14 @@ func (e ReviewInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReviewInfo.%s: %s%s",
+		"invalid %sReplyReviewReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReviewInfoValidationError{}
+var _ error = ReplyReviewReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -976,24 +4035,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReviewInfoValidationError{}
+} = ReplyReviewReplyValidationError{}
 
-// Validate checks the field values on AuditReviewRequest with the rules
+// Validate checks the field values on AppealReviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuditReviewRequest) Validate() error {
+func (m *AppealReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditReviewRequest with the rules
+// ValidateAll checks the field values on AppealReviewRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditReviewRequestMultiError, or nil if none found.
-func (m *AuditReviewRequest) ValidateAll() error {
+// AppealReviewRequestMultiError, or nil if none found.
+func (m *AppealReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditReviewRequest) validate(all bool) error {
+func (m *AppealReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1001,7 +4060,7 @@ func (m *AuditReviewRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetReviewID() <= 0 {
-		err := AuditReviewRequestValidationError{
+		err := AppealReviewRequestValidationError{
 			field:  "ReviewID",
 			reason: "value must be greater than 0",
 		}
@@ -1011,10 +4070,12 @@ func (m *AuditReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStatus() <= 0 {
-		err := AuditReviewRequestValidationError{
-			field:  "Status",
-			reason: "value must be greater than 0",
+	// no validation rules for StoreID
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 2 || l > 200 {
+		err := AppealReviewRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 2 and 200 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1022,10 +4083,10 @@ func (m *AuditReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
-		err := AuditReviewRequestValidationError{
-			field:  "OpUser",
-			reason: "value length must be at least 2 runes",
+	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
+		err := AppealReviewRequestValidationError{
+			field:  "Content",
+			reason: "value length must be between 2 and 200 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1033,10 +4094,14 @@ func (m *AuditReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetOpReason()) < 2 {
-		err := AuditReviewRequestValidationError{
-			field:  "OpReason",
-			reason: "value length must be at least 2 runes",
+	// no validation rules for PicInfo
+
+	// no validation rules for VideoInfo
+
+	if len(m.GetMedia()) > 10 {
+		err := AppealReviewRequestValidationError{
+			field:  "Media",
+			reason: "value must contain no more than 10 item(s)",
 		}
 		if !all {
 			return err
@@ -1044,24 +4109,58 @@ func (m *AuditReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.OpRemarks != nil {
-		// no validation rules for OpRemarks
+	for idx, item := range m.GetMedia() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppealReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppealReviewRequestValidationError{
+						field:  fmt.Sprintf("Media[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppealReviewRequestValidationError{
+					field:  fmt.Sprintf("Media[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Version != nil {
+		// no validation rules for Version
 	}
 
 	if len(errors) > 0 {
-		return AuditReviewRequestMultiError(errors)
+		return AppealReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by AuditReviewRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AuditReviewRequestMultiError []error
+// AppealReviewRequestMultiError is an error wrapping multiple validation
+// errors returned by AppealReviewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AppealReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditReviewRequestMultiError) Error() string {
+func (m AppealReviewRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1070,11 +4169,11 @@ func (m AuditReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditReviewRequestMultiError) AllErrors() []error { return m }
+func (m AppealReviewRequestMultiError) AllErrors() []error { return m }
 
-// AuditReviewRequestValidationError is the validation error returned by
-// AuditReviewRequest.Validate if the designated constraints aren't met.
-type AuditReviewRequestValidationError struct {
+// AppealReviewRequestValidationError is the validation error returned by
+// AppealReviewRequest.Validate if the designated constraints aren't met.
+type AppealReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1082,24 +4181,24 @@ type AuditReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditReviewRequestValidationError) Field() string { return e.field }
+func (e AppealReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditReviewRequestValidationError) Reason() string { return e.reason }
+func (e AppealReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditReviewRequestValidationError) Cause() error { return e.cause }
+func (e AppealReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditReviewRequestValidationError) Key() bool { return e.key }
+func (e AppealReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditReviewRequestValidationError) ErrorName() string {
-	return "AuditReviewRequestValidationError"
+func (e AppealReviewRequestValidationError) ErrorName() string {
+	return "AppealReviewRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuditReviewRequestValidationError) Error() string {
+func (e AppealReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1111,14 +4210,14 @@ func (e AuditReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditReviewRequest.%s: %s%s",
+		"invalid %sAppealReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditReviewRequestValidationError{}
+var _ error = AppealReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1126,48 +4225,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditReviewRequestValidationError{}
+} = AppealReviewRequestValidationError{}
 
-// Validate checks the field values on AuditReviewReply with the rules defined
+// Validate checks the field values on AppealReviewReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *AuditReviewReply) Validate() error {
+func (m *AppealReviewReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditReviewReply with the rules
+// ValidateAll checks the field values on AppealReviewReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditReviewReplyMultiError, or nil if none found.
-func (m *AuditReviewReply) ValidateAll() error {
+// AppealReviewReplyMultiError, or nil if none found.
+func (m *AppealReviewReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditReviewReply) validate(all bool) error {
+func (m *AppealReviewReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReviewID
+	// no validation rules for AppealID
 
-	// no validation rules for Status
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return AuditReviewReplyMultiError(errors)
+		return AppealReviewReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by AuditReviewReply.ValidateAll() if the designated constraints
+// AppealReviewReplyMultiError is an error wrapping multiple validation errors
+// returned by AppealReviewReply.ValidateAll() if the designated constraints
 // aren't met.
-type AuditReviewReplyMultiError []error
+type AppealReviewReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditReviewReplyMultiError) Error() string {
+func (m AppealReviewReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1176,11 +4275,11 @@ func (m AuditReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditReviewReplyMultiError) AllErrors() []error { return m }
+func (m AppealReviewReplyMultiError) AllErrors() []error { return m }
 
-// AuditReviewReplyValidationError is the validation error returned by
-// AuditReviewReply.Validate if the designated constraints aren't met.
-type AuditReviewReplyValidationError struct {
+// AppealReviewReplyValidationError is the validation error returned by
+// AppealReviewReply.Validate if the designated constraints aren't met.
+type AppealReviewReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1188,22 +4287,24 @@ type AuditReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditReviewReplyValidationError) Field() string { return e.field }
+func (e AppealReviewReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditReviewReplyValidationError) Reason() string { return e.reason }
+func (e AppealReviewReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditReviewReplyValidationError) Cause() error { return e.cause }
+func (e AppealReviewReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditReviewReplyValidationError) Key() bool { return e.key }
+func (e AppealReviewReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditReviewReplyValidationError) ErrorName() string { return "AuditReviewReplyValidationError" }
+func (e AppealReviewReplyValidationError) ErrorName() string {
+	return "AppealReviewReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuditReviewReplyValidationError) Error() string {
+func (e AppealReviewReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1215,14 +4316,14 @@ func (e AuditReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditReviewReply.%s: %s%s",
+		"invalid %sAppealReviewReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditReviewReplyValidationError{}
+var _ error = AppealReviewReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1230,32 +4331,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditReviewReplyValidationError{}
+} = AppealReviewReplyValidationError{}
 
-// Validate checks the field values on ReplyReviewRequest with the rules
+// Validate checks the field values on AuditAppealRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReplyReviewRequest) Validate() error {
+func (m *AuditAppealRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReplyReviewRequest with the rules
+// ValidateAll checks the field values on AuditAppealRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReplyReviewRequestMultiError, or nil if none found.
-func (m *ReplyReviewRequest) ValidateAll() error {
+// AuditAppealRequestMultiError, or nil if none found.
+func (m *AuditAppealRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReplyReviewRequest) validate(all bool) error {
+func (m *AuditAppealRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
+	var errors []error
+
+	if m.GetAppealID() <= 0 {
+		err := AuditAppealRequestValidationError{
+			field:  "AppealID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if m.GetReviewID() <= 0 {
-		err := ReplyReviewRequestValidationError{
+		err := AuditAppealRequestValidationError{
 			field:  "ReviewID",
 			reason: "value must be greater than 0",
 		}
@@ -1265,10 +4377,10 @@ func (m *ReplyReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStoreID() <= 0 {
-		err := ReplyReviewRequestValidationError{
-			field:  "StoreID",
-			reason: "value must be greater than 0",
+	if _, ok := _AuditAppealRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := AuditAppealRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [APPEAL_STATUS_APPROVED APPEAL_STATUS_REJECTED]",
 		}
 		if !all {
 			return err
@@ -1276,10 +4388,10 @@ func (m *ReplyReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
-		err := ReplyReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 2 and 200 runes, inclusive",
+	if _, ok := AppealStatus_name[int32(m.GetStatus())]; !ok {
+		err := AuditAppealRequestValidationError{
+			field:  "Status",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -1287,24 +4399,30 @@ func (m *ReplyReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
+	// no validation rules for OpUser
 
-	// no validation rules for VideoInfo
+	if m.OpRemarks != nil {
+		// no validation rules for OpRemarks
+	}
+
+	if m.Version != nil {
+		// no validation rules for Version
+	}
 
 	if len(errors) > 0 {
-		return ReplyReviewRequestMultiError(errors)
+		return AuditAppealRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplyReviewRequestMultiError is an error wrapping multiple validation errors
-// returned by ReplyReviewRequest.ValidateAll() if the designated constraints
+// AuditAppealRequestMultiError is an error wrapping multiple validation errors
+// returned by AuditAppealRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ReplyReviewRequestMultiError []error
+type AuditAppealRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplyReviewRequestMultiError) Error() string {
+func (m AuditAppealRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1313,11 +4431,11 @@ func (m ReplyReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplyReviewRequestMultiError) AllErrors() []error { return m }
+func (m AuditAppealRequestMultiError) AllErrors() []error { return m }
 
-// ReplyReviewRequestValidationError is the validation error returned by
-// ReplyReviewRequest.Validate if the designated constraints aren't met.
-type ReplyReviewRequestValidationError struct {
+// AuditAppealRequestValidationError is the validation error returned by
+// AuditAppealRequest.Validate if the designated constraints aren't met.
+type AuditAppealRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1325,24 +4443,24 @@ type ReplyReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplyReviewRequestValidationError) Field() string { return e.field }
+func (e AuditAppealRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplyReviewRequestValidationError) Reason() string { return e.reason }
+func (e AuditAppealRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplyReviewRequestValidationError) Cause() error { return e.cause }
+func (e AuditAppealRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplyReviewRequestValidationError) Key() bool { return e.key }
+func (e AuditAppealRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplyReviewRequestValidationError) ErrorName() string {
-	return "ReplyReviewRequestValidationError"
+func (e AuditAppealRequestValidationError) ErrorName() string {
+	return "AuditAppealRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReplyReviewRequestValidationError) Error() string {
+func (e AuditAppealRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1354,14 +4472,14 @@ func (e ReplyReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplyReviewRequest.%s: %s%s",
+		"invalid %sAuditAppealRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplyReviewRequestValidationError{}
+var _ error = AuditAppealRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1369,46 +4487,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplyReviewRequestValidationError{}
+} = AuditAppealRequestValidationError{}
 
-// Validate checks the field values on ReplyReviewReply with the rules defined
+var _AuditAppealRequest_Status_InLookup = map[AppealStatus]struct{}{
+	20: {},
+	30: {},
+}
+
+// Validate checks the field values on AuditAppealReply with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ReplyReviewReply) Validate() error {
+func (m *AuditAppealReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReplyReviewReply with the rules
+// ValidateAll checks the field values on AuditAppealReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReplyReviewReplyMultiError, or nil if none found.
-func (m *ReplyReviewReply) ValidateAll() error {
+// AuditAppealReplyMultiError, or nil if none found.
+func (m *AuditAppealReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReplyReviewReply) validate(all bool) error {
+func (m *AuditAppealReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ReplyID
-
 	if len(errors) > 0 {
-		return ReplyReviewReplyMultiError(errors)
+		return AuditAppealReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReplyReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by ReplyReviewReply.ValidateAll() if the designated constraints
+// AuditAppealReplyMultiError is an error wrapping multiple validation errors
+// returned by AuditAppealReply.ValidateAll() if the designated constraints
 // aren't met.
-type ReplyReviewReplyMultiError []error
+type AuditAppealReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReplyReviewReplyMultiError) Error() string {
+func (m AuditAppealReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1417,11 +4538,11 @@ func (m ReplyReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReplyReviewReplyMultiError) AllErrors() []error { return m }
+func (m AuditAppealReplyMultiError) AllErrors() []error { return m }
 
-// ReplyReviewReplyValidationError is the validation error returned by
-// ReplyReviewReply.Validate if the designated constraints aren't met.
-type ReplyReviewReplyValidationError struct {
+// AuditAppealReplyValidationError is the validation error returned by
+// AuditAppealReply.Validate if the designated constraints aren't met.
+type AuditAppealReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1429,22 +4550,22 @@ type ReplyReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReplyReviewReplyValidationError) Field() string { return e.field }
+func (e AuditAppealReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReplyReviewReplyValidationError) Reason() string { return e.reason }
+func (e AuditAppealReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReplyReviewReplyValidationError) Cause() error { return e.cause }
+func (e AuditAppealReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReplyReviewReplyValidationError) Key() bool { return e.key }
+func (e AuditAppealReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReplyReviewReplyValidationError) ErrorName() string { return "ReplyReviewReplyValidationError" }
+func (e AuditAppealReplyValidationError) ErrorName() string { return "AuditAppealReplyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReplyReviewReplyValidationError) Error() string {
+func (e AuditAppealReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1456,14 +4577,14 @@ func (e ReplyReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReplyReviewReply.%s: %s%s",
+		"invalid %sAuditAppealReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReplyReviewReplyValidationError{}
+var _ error = AuditAppealReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1471,44 +4592,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReplyReviewReplyValidationError{}
+} = AuditAppealReplyValidationError{}
 
-// Validate checks the field values on AppealReviewRequest with the rules
+// Validate checks the field values on ListReviewByUserIDRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AppealReviewRequest) Validate() error {
+func (m *ListReviewByUserIDRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AppealReviewRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListReviewByUserIDRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AppealReviewRequestMultiError, or nil if none found.
-func (m *AppealReviewRequest) ValidateAll() error {
+// ListReviewByUserIDRequestMultiError, or nil if none found.
+func (m *ListReviewByUserIDRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AppealReviewRequest) validate(all bool) error {
+func (m *ListReviewByUserIDRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetReviewID() <= 0 {
-		err := AppealReviewRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetStoreID() <= 0 {
-		err := AppealReviewRequestValidationError{
-			field:  "StoreID",
+	if m.GetUserID() <= 0 {
+		err := ListReviewByUserIDRequestValidationError{
+			field:  "UserID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1517,10 +4627,10 @@ func (m *AppealReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetReason()); l < 2 || l > 200 {
-		err := AppealReviewRequestValidationError{
-			field:  "Reason",
-			reason: "value length must be between 2 and 200 runes, inclusive",
+	if m.GetPage() < 0 {
+		err := ListReviewByUserIDRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -1528,10 +4638,10 @@ func (m *AppealReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetContent()); l < 2 || l > 200 {
-		err := AppealReviewRequestValidationError{
-			field:  "Content",
-			reason: "value length must be between 2 and 200 runes, inclusive",
+	if m.GetSize() <= 0 {
+		err := ListReviewByUserIDRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -1539,24 +4649,24 @@ func (m *AppealReviewRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for PicInfo
+	// no validation rules for PageToken
 
-	// no validation rules for VideoInfo
+	// no validation rules for Raw
 
 	if len(errors) > 0 {
-		return AppealReviewRequestMultiError(errors)
+		return ListReviewByUserIDRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AppealReviewRequestMultiError is an error wrapping multiple validation
-// errors returned by AppealReviewRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AppealReviewRequestMultiError []error
+// ListReviewByUserIDRequestMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByUserIDRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListReviewByUserIDRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AppealReviewRequestMultiError) Error() string {
+func (m ListReviewByUserIDRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1565,11 +4675,11 @@ func (m AppealReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AppealReviewRequestMultiError) AllErrors() []error { return m }
+func (m ListReviewByUserIDRequestMultiError) AllErrors() []error { return m }
 
-// AppealReviewRequestValidationError is the validation error returned by
-// AppealReviewRequest.Validate if the designated constraints aren't met.
-type AppealReviewRequestValidationError struct {
+// ListReviewByUserIDRequestValidationError is the validation error returned by
+// ListReviewByUserIDRequest.Validate if the designated constraints aren't met.
+type ListReviewByUserIDRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1577,24 +4687,24 @@ type AppealReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppealReviewRequestValidationError) Field() string { return e.field }
+func (e ListReviewByUserIDRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppealReviewRequestValidationError) Reason() string { return e.reason }
+func (e ListReviewByUserIDRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppealReviewRequestValidationError) Cause() error { return e.cause }
+func (e ListReviewByUserIDRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppealReviewRequestValidationError) Key() bool { return e.key }
+func (e ListReviewByUserIDRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppealReviewRequestValidationError) ErrorName() string {
-	return "AppealReviewRequestValidationError"
+func (e ListReviewByUserIDRequestValidationError) ErrorName() string {
+	return "ListReviewByUserIDRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AppealReviewRequestValidationError) Error() string {
+func (e ListReviewByUserIDRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1606,14 +4716,14 @@ func (e AppealReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppealReviewRequest.%s: %s%s",
+		"invalid %sListReviewByUserIDRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppealReviewRequestValidationError{}
+var _ error = ListReviewByUserIDRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1621,46 +4731,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppealReviewRequestValidationError{}
+} = ListReviewByUserIDRequestValidationError{}
 
-// Validate checks the field values on AppealReviewReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AppealReviewReply) Validate() error {
+// Validate checks the field values on ListReviewByUserIDReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReviewByUserIDReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AppealReviewReply with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListReviewByUserIDReply with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AppealReviewReplyMultiError, or nil if none found.
-func (m *AppealReviewReply) ValidateAll() error {
+// ListReviewByUserIDReplyMultiError, or nil if none found.
+func (m *ListReviewByUserIDReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AppealReviewReply) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *ListReviewByUserIDReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReviewByUserIDReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReviewByUserIDReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReviewByUserIDReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
+	// no validation rules for NextPageToken
 
-	// no validation rules for AppealID
+	// no validation rules for Total
 
 	if len(errors) > 0 {
-		return AppealReviewReplyMultiError(errors)
+		return ListReviewByUserIDReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AppealReviewReplyMultiError is an error wrapping multiple validation errors
-// returned by AppealReviewReply.ValidateAll() if the designated constraints
-// aren't met.
-type AppealReviewReplyMultiError []error
+// ListReviewByUserIDReplyMultiError is an error wrapping multiple validation
+// errors returned by ListReviewByUserIDReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListReviewByUserIDReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AppealReviewReplyMultiError) Error() string {
+func (m ListReviewByUserIDReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1669,11 +4815,11 @@ func (m AppealReviewReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AppealReviewReplyMultiError) AllErrors() []error { return m }
+func (m ListReviewByUserIDReplyMultiError) AllErrors() []error { return m }
 
-// AppealReviewReplyValidationError is the validation error returned by
-// AppealReviewReply.Validate if the designated constraints aren't met.
-type AppealReviewReplyValidationError struct {
+// ListReviewByUserIDReplyValidationError is the validation error returned by
+// ListReviewByUserIDReply.Validate if the designated constraints aren't met.
+type ListReviewByUserIDReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1681,24 +4827,24 @@ type AppealReviewReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppealReviewReplyValidationError) Field() string { return e.field }
+func (e ListReviewByUserIDReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppealReviewReplyValidationError) Reason() string { return e.reason }
+func (e ListReviewByUserIDReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppealReviewReplyValidationError) Cause() error { return e.cause }
+func (e ListReviewByUserIDReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppealReviewReplyValidationError) Key() bool { return e.key }
+func (e ListReviewByUserIDReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppealReviewReplyValidationError) ErrorName() string {
-	return "AppealReviewReplyValidationError"
+func (e ListReviewByUserIDReplyValidationError) ErrorName() string {
+	return "ListReviewByUserIDReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AppealReviewReplyValidationError) Error() string {
+func (e ListReviewByUserIDReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1710,14 +4856,14 @@ func (e AppealReviewReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAppealReviewReply.%s: %s%s",
+		"invalid %sListReviewByUserIDReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppealReviewReplyValidationError{}
+var _ error = ListReviewByUserIDReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1725,34 +4871,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppealReviewReplyValidationError{}
+} = ListReviewByUserIDReplyValidationError{}
 
-// Validate checks the field values on AuditAppealRequest with the rules
+// Validate checks the field values on CreateMediaUploadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AuditAppealRequest) Validate() error {
+func (m *CreateMediaUploadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditAppealRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateMediaUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditAppealRequestMultiError, or nil if none found.
-func (m *AuditAppealRequest) ValidateAll() error {
+// CreateMediaUploadRequestMultiError, or nil if none found.
+func (m *CreateMediaUploadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditAppealRequest) validate(all bool) error {
+func (m *CreateMediaUploadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetAppealID() <= 0 {
-		err := AuditAppealRequestValidationError{
-			field:  "AppealID",
-			reason: "value must be greater than 0",
+	if _, ok := _CreateMediaUploadRequest_Type_NotInLookup[m.GetType()]; ok {
+		err := CreateMediaUploadRequestValidationError{
+			field:  "Type",
+			reason: "value must not be in list [MEDIA_TYPE_UNSPECIFIED]",
 		}
 		if !all {
 			return err
@@ -1760,10 +4906,10 @@ func (m *AuditAppealRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetReviewID() <= 0 {
-		err := AuditAppealRequestValidationError{
-			field:  "ReviewID",
-			reason: "value must be greater than 0",
+	if _, ok := MediaType_name[int32(m.GetType())]; !ok {
+		err := CreateMediaUploadRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -1771,10 +4917,10 @@ func (m *AuditAppealRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetStatus() <= 0 {
-		err := AuditAppealRequestValidationError{
-			field:  "Status",
-			reason: "value must be greater than 0",
+	if l := utf8.RuneCountInString(m.GetContentType()); l < 1 || l > 64 {
+		err := CreateMediaUploadRequestValidationError{
+			field:  "ContentType",
+			reason: "value length must be between 1 and 64 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1782,10 +4928,10 @@ func (m *AuditAppealRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetOpUser()) < 2 {
-		err := AuditAppealRequestValidationError{
-			field:  "OpUser",
-			reason: "value length must be at least 2 runes",
+	if m.GetSize() <= 0 {
+		err := CreateMediaUploadRequestValidationError{
+			field:  "Size",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -1793,24 +4939,31 @@ func (m *AuditAppealRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.OpRemarks != nil {
-		// no validation rules for OpRemarks
+	if m.GetDuration() < 0 {
+		err := CreateMediaUploadRequestValidationError{
+			field:  "Duration",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return AuditAppealRequestMultiError(errors)
+		return CreateMediaUploadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditAppealRequestMultiError is an error wrapping multiple validation errors
-// returned by AuditAppealRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AuditAppealRequestMultiError []error
+// CreateMediaUploadRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateMediaUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMediaUploadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditAppealRequestMultiError) Error() string {
+func (m CreateMediaUploadRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1819,11 +4972,11 @@ func (m AuditAppealRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditAppealRequestMultiError) AllErrors() []error { return m }
+func (m CreateMediaUploadRequestMultiError) AllErrors() []error { return m }
 
-// AuditAppealRequestValidationError is the validation error returned by
-// AuditAppealRequest.Validate if the designated constraints aren't met.
-type AuditAppealRequestValidationError struct {
+// CreateMediaUploadRequestValidationError is the validation error returned by
+// CreateMediaUploadRequest.Validate if the designated constraints aren't met.
+type CreateMediaUploadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1831,24 +4984,24 @@ type AuditAppealRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditAppealRequestValidationError) Field() string { return e.field }
+func (e CreateMediaUploadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditAppealRequestValidationError) Reason() string { return e.reason }
+func (e CreateMediaUploadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditAppealRequestValidationError) Cause() error { return e.cause }
+func (e CreateMediaUploadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditAppealRequestValidationError) Key() bool { return e.key }
+func (e CreateMediaUploadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditAppealRequestValidationError) ErrorName() string {
-	return "AuditAppealRequestValidationError"
+func (e CreateMediaUploadRequestValidationError) ErrorName() string {
+	return "CreateMediaUploadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AuditAppealRequestValidationError) Error() string {
+func (e CreateMediaUploadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1860,14 +5013,14 @@ func (e AuditAppealRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditAppealRequest.%s: %s%s",
+		"invalid %sCreateMediaUploadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditAppealRequestValidationError{}
+var _ error = CreateMediaUploadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1875,44 +5028,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditAppealRequestValidationError{}
+} = CreateMediaUploadRequestValidationError{}
 
-// Validate checks the field values on AuditAppealReply with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AuditAppealReply) Validate() error {
+var _CreateMediaUploadRequest_Type_NotInLookup = map[MediaType]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateMediaUploadReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMediaUploadReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuditAppealReply with the rules
+// ValidateAll checks the field values on CreateMediaUploadReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AuditAppealReplyMultiError, or nil if none found.
-func (m *AuditAppealReply) ValidateAll() error {
+// CreateMediaUploadReplyMultiError, or nil if none found.
+func (m *CreateMediaUploadReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuditAppealReply) validate(all bool) error {
+func (m *CreateMediaUploadReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for MediaID
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for UploadUrl
+
+	// no validation rules for Method
+
+	// no validation rules for Headers
+
+	// no validation rules for ExpireAt
+
 	if len(errors) > 0 {
-		return AuditAppealReplyMultiError(errors)
+		return CreateMediaUploadReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuditAppealReplyMultiError is an error wrapping multiple validation errors
-// returned by AuditAppealReply.ValidateAll() if the designated constraints
-// aren't met.
-type AuditAppealReplyMultiError []error
+// CreateMediaUploadReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateMediaUploadReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMediaUploadReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuditAppealReplyMultiError) Error() string {
+func (m CreateMediaUploadReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1921,11 +5090,11 @@ func (m AuditAppealReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuditAppealReplyMultiError) AllErrors() []error { return m }
+func (m CreateMediaUploadReplyMultiError) AllErrors() []error { return m }
 
-// AuditAppealReplyValidationError is the validation error returned by
-// AuditAppealReply.Validate if the designated constraints aren't met.
-type AuditAppealReplyValidationError struct {
+// CreateMediaUploadReplyValidationError is the validation error returned by
+// CreateMediaUploadReply.Validate if the designated constraints aren't met.
+type CreateMediaUploadReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1933,22 +5102,24 @@ type AuditAppealReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuditAppealReplyValidationError) Field() string { return e.field }
+func (e CreateMediaUploadReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuditAppealReplyValidationError) Reason() string { return e.reason }
+func (e CreateMediaUploadReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuditAppealReplyValidationError) Cause() error { return e.cause }
+func (e CreateMediaUploadReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuditAppealReplyValidationError) Key() bool { return e.key }
+func (e CreateMediaUploadReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuditAppealReplyValidationError) ErrorName() string { return "AuditAppealReplyValidationError" }
+func (e CreateMediaUploadReplyValidationError) ErrorName() string {
+	return "CreateMediaUploadReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuditAppealReplyValidationError) Error() string {
+func (e CreateMediaUploadReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1960,14 +5131,14 @@ func (e AuditAppealReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuditAppealReply.%s: %s%s",
+		"invalid %sCreateMediaUploadReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuditAppealReplyValidationError{}
+var _ error = CreateMediaUploadReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -1975,55 +5146,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuditAppealReplyValidationError{}
+} = CreateMediaUploadReplyValidationError{}
 
-// Validate checks the field values on ListReviewByUserIDRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CompleteMediaUploadRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListReviewByUserIDRequest) Validate() error {
+func (m *CompleteMediaUploadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReviewByUserIDRequest with the
+// ValidateAll checks the field values on CompleteMediaUploadRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListReviewByUserIDRequestMultiError, or nil if none found.
-func (m *ListReviewByUserIDRequest) ValidateAll() error {
+// CompleteMediaUploadRequestMultiError, or nil if none found.
+func (m *CompleteMediaUploadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReviewByUserIDRequest) validate(all bool) error {
+func (m *CompleteMediaUploadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUserID() <= 0 {
-		err := ListReviewByUserIDRequestValidationError{
-			field:  "UserID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetPage() <= 0 {
-		err := ListReviewByUserIDRequestValidationError{
-			field:  "Page",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetSize() <= 0 {
-		err := ListReviewByUserIDRequestValidationError{
-			field:  "Size",
+	if m.GetMediaID() <= 0 {
+		err := CompleteMediaUploadRequestValidationError{
+			field:  "MediaID",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -2033,19 +5182,19 @@ func (m *ListReviewByUserIDRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListReviewByUserIDRequestMultiError(errors)
+		return CompleteMediaUploadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReviewByUserIDRequestMultiError is an error wrapping multiple validation
-// errors returned by ListReviewByUserIDRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListReviewByUserIDRequestMultiError []error
+// CompleteMediaUploadRequestMultiError is an error wrapping multiple
+// validation errors returned by CompleteMediaUploadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CompleteMediaUploadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReviewByUserIDRequestMultiError) Error() string {
+func (m CompleteMediaUploadRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2054,11 +5203,11 @@ func (m ListReviewByUserIDRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReviewByUserIDRequestMultiError) AllErrors() []error { return m }
+func (m CompleteMediaUploadRequestMultiError) AllErrors() []error { return m }
 
-// ListReviewByUserIDRequestValidationError is the validation error returned by
-// ListReviewByUserIDRequest.Validate if the designated constraints aren't met.
-type ListReviewByUserIDRequestValidationError struct {
+// CompleteMediaUploadRequestValidationError is the validation error returned
+// by CompleteMediaUploadRequest.Validate if the designated constraints aren't met.
+type CompleteMediaUploadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2066,24 +5215,24 @@ type ListReviewByUserIDRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReviewByUserIDRequestValidationError) Field() string { return e.field }
+func (e CompleteMediaUploadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReviewByUserIDRequestValidationError) Reason() string { return e.reason }
+func (e CompleteMediaUploadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReviewByUserIDRequestValidationError) Cause() error { return e.cause }
+func (e CompleteMediaUploadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReviewByUserIDRequestValidationError) Key() bool { return e.key }
+func (e CompleteMediaUploadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReviewByUserIDRequestValidationError) ErrorName() string {
-	return "ListReviewByUserIDRequestValidationError"
+func (e CompleteMediaUploadRequestValidationError) ErrorName() string {
+	return "CompleteMediaUploadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListReviewByUserIDRequestValidationError) Error() string {
+func (e CompleteMediaUploadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2095,14 +5244,14 @@ func (e ListReviewByUserIDRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReviewByUserIDRequest.%s: %s%s",
+		"invalid %sCompleteMediaUploadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReviewByUserIDRequestValidationError{}
+var _ error = CompleteMediaUploadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2110,78 +5259,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReviewByUserIDRequestValidationError{}
+} = CompleteMediaUploadRequestValidationError{}
 
-// Validate checks the field values on ListReviewByUserIDReply with the rules
+// Validate checks the field values on CompleteMediaUploadReply with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListReviewByUserIDReply) Validate() error {
+func (m *CompleteMediaUploadReply) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListReviewByUserIDReply with the
+// ValidateAll checks the field values on CompleteMediaUploadReply with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListReviewByUserIDReplyMultiError, or nil if none found.
-func (m *ListReviewByUserIDReply) ValidateAll() error {
+// CompleteMediaUploadReplyMultiError, or nil if none found.
+func (m *CompleteMediaUploadReply) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListReviewByUserIDReply) validate(all bool) error {
+func (m *CompleteMediaUploadReply) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetList() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListReviewByUserIDReplyValidationError{
-						field:  fmt.Sprintf("List[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListReviewByUserIDReplyValidationError{
-						field:  fmt.Sprintf("List[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetMedia()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompleteMediaUploadReplyValidationError{
+					field:  "Media",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListReviewByUserIDReplyValidationError{
-					field:  fmt.Sprintf("List[%v]", idx),
+				errors = append(errors, CompleteMediaUploadReplyValidationError{
+					field:  "Media",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMedia()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompleteMediaUploadReplyValidationError{
+				field:  "Media",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListReviewByUserIDReplyMultiError(errors)
+		return CompleteMediaUploadReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListReviewByUserIDReplyMultiError is an error wrapping multiple validation
-// errors returned by ListReviewByUserIDReply.ValidateAll() if the designated
+// CompleteMediaUploadReplyMultiError is an error wrapping multiple validation
+// errors returned by CompleteMediaUploadReply.ValidateAll() if the designated
 // constraints aren't met.
-type ListReviewByUserIDReplyMultiError []error
+type CompleteMediaUploadReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListReviewByUserIDReplyMultiError) Error() string {
+func (m CompleteMediaUploadReplyMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2190,11 +5334,11 @@ func (m ListReviewByUserIDReplyMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListReviewByUserIDReplyMultiError) AllErrors() []error { return m }
+func (m CompleteMediaUploadReplyMultiError) AllErrors() []error { return m }
 
-// ListReviewByUserIDReplyValidationError is the validation error returned by
-// ListReviewByUserIDReply.Validate if the designated constraints aren't met.
-type ListReviewByUserIDReplyValidationError struct {
+// CompleteMediaUploadReplyValidationError is the validation error returned by
+// CompleteMediaUploadReply.Validate if the designated constraints aren't met.
+type CompleteMediaUploadReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2202,24 +5346,24 @@ type ListReviewByUserIDReplyValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListReviewByUserIDReplyValidationError) Field() string { return e.field }
+func (e CompleteMediaUploadReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListReviewByUserIDReplyValidationError) Reason() string { return e.reason }
+func (e CompleteMediaUploadReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListReviewByUserIDReplyValidationError) Cause() error { return e.cause }
+func (e CompleteMediaUploadReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListReviewByUserIDReplyValidationError) Key() bool { return e.key }
+func (e CompleteMediaUploadReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListReviewByUserIDReplyValidationError) ErrorName() string {
-	return "ListReviewByUserIDReplyValidationError"
+func (e CompleteMediaUploadReplyValidationError) ErrorName() string {
+	return "CompleteMediaUploadReplyValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListReviewByUserIDReplyValidationError) Error() string {
+func (e CompleteMediaUploadReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2231,14 +5375,14 @@ func (e ListReviewByUserIDReplyValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListReviewByUserIDReply.%s: %s%s",
+		"invalid %sCompleteMediaUploadReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListReviewByUserIDReplyValidationError{}
+var _ error = CompleteMediaUploadReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -2246,4 +5390,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListReviewByUserIDReplyValidationError{}
+} = CompleteMediaUploadReplyValidationError{}
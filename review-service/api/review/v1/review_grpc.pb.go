@@ -26,6 +26,12 @@ type ReviewClient interface {
 	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*CreateReviewReply, error)
 	// C端获取评价详情
 	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewReply, error)
+	// C端修改评价,只能修改待审核的评价,修改后重新审核
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewReply, error)
+	// C端追评,在评价创建后的一段时间内可以追加一次评价,追评需要单独审核
+	AppendReview(ctx context.Context, in *AppendReviewRequest, opts ...grpc.CallOption) (*AppendReviewReply, error)
+	// O端审核追评
+	AuditAppendReview(ctx context.Context, in *AuditAppendReviewRequest, opts ...grpc.CallOption) (*AuditAppendReviewReply, error)
 	// O端审核评价
 	AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error)
 	// B端回复评价
@@ -38,6 +44,16 @@ type ReviewClient interface {
 	ListReviewByUserID(ctx context.Context, in *ListReviewByUserIDRequest, opts ...grpc.CallOption) (*ListReviewByUserIDReply, error)
 	// 根据商家ID查询评价列表（分页）(使用ES)
 	ListReviewByStoreID(ctx context.Context, in *ListReviewByStoreIDRequest, opts ...grpc.CallOption) (*ListReviewByStoreIDReply, error)
+	// 商家的评价统计:平均分、星级分布、有图率、回复率、好评率(使用ES聚合)
+	GetStoreReviewStats(ctx context.Context, in *GetStoreReviewStatsRequest, opts ...grpc.CallOption) (*GetStoreReviewStatsReply, error)
+	// 商品(SPU)的评价统计(使用ES聚合)
+	GetSpuReviewStats(ctx context.Context, in *GetSpuReviewStatsRequest, opts ...grpc.CallOption) (*GetSpuReviewStatsReply, error)
+	// C端/B端搜索评价:关键词全文检索+条件过滤,返回高亮片段(使用ES)
+	SearchReviews(ctx context.Context, in *SearchReviewsRequest, opts ...grpc.CallOption) (*SearchReviewsReply, error)
+	// C端/B端申请上传图片或视频,返回预签名的上传地址,上传之后调用CompleteMediaUpload
+	CreateMediaUpload(ctx context.Context, in *CreateMediaUploadRequest, opts ...grpc.CallOption) (*CreateMediaUploadReply, error)
+	// C端/B端确认上传完成,校验文件的类型和大小,图片生成缩略图
+	CompleteMediaUpload(ctx context.Context, in *CompleteMediaUploadRequest, opts ...grpc.CallOption) (*CompleteMediaUploadReply, error)
 }
 
 type reviewClient struct {
@@ -66,6 +82,33 @@ func (c *reviewClient) GetReview(ctx context.Context, in *GetReviewRequest, opts
 	return out, nil
 }
 
+func (c *reviewClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*UpdateReviewReply, error) {
+	out := new(UpdateReviewReply)
+	err := c.cc.Invoke(ctx, "/api.review.v1.Review/UpdateReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) AppendReview(ctx context.Context, in *AppendReviewRequest, opts ...grpc.CallOption) (*AppendReviewReply, error) {
+	out := new(AppendReviewReply)
+	err := c.cc.Invoke(ctx, "/api.review.v1.Review/AppendReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) AuditAppendReview(ctx context.Context, in *AuditAppendReviewRequest, opts ...grpc.CallOption) (*AuditAppendReviewReply, error) {
+	out := new(AuditAppendReviewReply)
+	err := c.cc.Invoke(ctx, "/api.review.v1.Review/AuditAppendReview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reviewClient) AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...grpc.CallOption) (*AuditReviewReply, error) {
 	out := new(AuditReviewReply)
 	err := c.cc.Invoke(ctx, "/api.review.v1.Review/AuditReview", in, out, opts...)
@@ -120,6 +163,51 @@ func (c *reviewClient) ListReviewByStoreID(ctx context.Context, in *ListReviewBy
 	return out, nil
 }
 
+func (c *reviewClient) GetStoreReviewStats(ctx context.Context, in *GetStoreReviewStatsRequest, opts ...grpc.CallOption) (*GetStoreReviewStatsReply, error) {
+	out := new(GetStoreReviewStatsReply)
+	err := c.cc.Invoke(ctx, "/api.review.v1.Review/GetStoreReviewStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) GetSpuReviewStats(ctx context.Context, in *GetSpuReviewStatsRequest, opts ...grpc.CallOption) (*GetSpuReviewStatsReply, error) {
+	out := new(GetSpuReviewStatsReply)
+	err := c.cc.Invoke(ctx, "/api.review.v1.Review/GetSpuReviewStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) SearchReviews(ctx context.Context, in *SearchReviewsRequest, opts ...grpc.CallOption) (*SearchReviewsReply, error) {
+	out := new(SearchReviewsReply)
+	err := c.cc.Invoke(ctx, "/api.review.v1.Review/SearchReviews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CreateMediaUpload(ctx context.Context, in *CreateMediaUploadRequest, opts ...grpc.CallOption) (*CreateMediaUploadReply, error) {
+	out := new(CreateMediaUploadReply)
+	err := c.cc.Invoke(ctx, "/api.review.v1.Review/CreateMediaUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewClient) CompleteMediaUpload(ctx context.Context, in *CompleteMediaUploadRequest, opts ...grpc.CallOption) (*CompleteMediaUploadReply, error) {
+	out := new(CompleteMediaUploadReply)
+	err := c.cc.Invoke(ctx, "/api.review.v1.Review/CompleteMediaUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReviewServer is the server API for Review service.
 // All implementations must embed UnimplementedReviewServer
 // for forward compatibility
@@ -128,6 +216,12 @@ type ReviewServer interface {
 	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error)
 	// C端获取评价详情
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
+	// C端修改评价,只能修改待审核的评价,修改后重新审核
+	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error)
+	// C端追评,在评价创建后的一段时间内可以追加一次评价,追评需要单独审核
+	AppendReview(context.Context, *AppendReviewRequest) (*AppendReviewReply, error)
+	// O端审核追评
+	AuditAppendReview(context.Context, *AuditAppendReviewRequest) (*AuditAppendReviewReply, error)
 	// O端审核评价
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
 	// B端回复评价
@@ -140,6 +234,16 @@ type ReviewServer interface {
 	ListReviewByUserID(context.Context, *ListReviewByUserIDRequest) (*ListReviewByUserIDReply, error)
 	// 根据商家ID查询评价列表（分页）(使用ES)
 	ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error)
+	// 商家的评价统计:平均分、星级分布、有图率、回复率、好评率(使用ES聚合)
+	GetStoreReviewStats(context.Context, *GetStoreReviewStatsRequest) (*GetStoreReviewStatsReply, error)
+	// 商品(SPU)的评价统计(使用ES聚合)
+	GetSpuReviewStats(context.Context, *GetSpuReviewStatsRequest) (*GetSpuReviewStatsReply, error)
+	// C端/B端搜索评价:关键词全文检索+条件过滤,返回高亮片段(使用ES)
+	SearchReviews(context.Context, *SearchReviewsRequest) (*SearchReviewsReply, error)
+	// C端/B端申请上传图片或视频,返回预签名的上传地址,上传之后调用CompleteMediaUpload
+	CreateMediaUpload(context.Context, *CreateMediaUploadRequest) (*CreateMediaUploadReply, error)
+	// C端/B端确认上传完成,校验文件的类型和大小,图片生成缩略图
+	CompleteMediaUpload(context.Context, *CompleteMediaUploadRequest) (*CompleteMediaUploadReply, error)
 	mustEmbedUnimplementedReviewServer()
 }
 
@@ -153,6 +257,15 @@ func (UnimplementedReviewServer) CreateReview(context.Context, *CreateReviewRequ
 func (UnimplementedReviewServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
 }
+func (UnimplementedReviewServer) UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedReviewServer) AppendReview(context.Context, *AppendReviewRequest) (*AppendReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendReview not implemented")
+}
+func (UnimplementedReviewServer) AuditAppendReview(context.Context, *AuditAppendReviewRequest) (*AuditAppendReviewReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditAppendReview not implemented")
+}
 func (UnimplementedReviewServer) AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuditReview not implemented")
 }
@@ -171,6 +284,21 @@ func (UnimplementedReviewServer) ListReviewByUserID(context.Context, *ListReview
 func (UnimplementedReviewServer) ListReviewByStoreID(context.Context, *ListReviewByStoreIDRequest) (*ListReviewByStoreIDReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReviewByStoreID not implemented")
 }
+func (UnimplementedReviewServer) GetStoreReviewStats(context.Context, *GetStoreReviewStatsRequest) (*GetStoreReviewStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoreReviewStats not implemented")
+}
+func (UnimplementedReviewServer) GetSpuReviewStats(context.Context, *GetSpuReviewStatsRequest) (*GetSpuReviewStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpuReviewStats not implemented")
+}
+func (UnimplementedReviewServer) SearchReviews(context.Context, *SearchReviewsRequest) (*SearchReviewsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchReviews not implemented")
+}
+func (UnimplementedReviewServer) CreateMediaUpload(context.Context, *CreateMediaUploadRequest) (*CreateMediaUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMediaUpload not implemented")
+}
+func (UnimplementedReviewServer) CompleteMediaUpload(context.Context, *CompleteMediaUploadRequest) (*CompleteMediaUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMediaUpload not implemented")
+}
 func (UnimplementedReviewServer) mustEmbedUnimplementedReviewServer() {}
 
 // UnsafeReviewServer may be embedded to opt out of forward compatibility for this service.
@@ -220,6 +348,60 @@ func _Review_GetReview_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.review.v1.Review/UpdateReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_AppendReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AppendReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.review.v1.Review/AppendReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AppendReview(ctx, req.(*AppendReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_AuditAppendReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditAppendReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).AuditAppendReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.review.v1.Review/AuditAppendReview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).AuditAppendReview(ctx, req.(*AuditAppendReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Review_AuditReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuditReviewRequest)
 	if err := dec(in); err != nil {
@@ -328,6 +510,96 @@ func _Review_ListReviewByStoreID_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Review_GetStoreReviewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreReviewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetStoreReviewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.review.v1.Review/GetStoreReviewStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetStoreReviewStats(ctx, req.(*GetStoreReviewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_GetSpuReviewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpuReviewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).GetSpuReviewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.review.v1.Review/GetSpuReviewStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).GetSpuReviewStats(ctx, req.(*GetSpuReviewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_SearchReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).SearchReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.review.v1.Review/SearchReviews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).SearchReviews(ctx, req.(*SearchReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_CreateMediaUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMediaUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).CreateMediaUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.review.v1.Review/CreateMediaUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).CreateMediaUpload(ctx, req.(*CreateMediaUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Review_CompleteMediaUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMediaUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServer).CompleteMediaUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.review.v1.Review/CompleteMediaUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServer).CompleteMediaUpload(ctx, req.(*CompleteMediaUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Review_ServiceDesc is the grpc.ServiceDesc for Review service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +615,18 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReview",
 			Handler:    _Review_GetReview_Handler,
 		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _Review_UpdateReview_Handler,
+		},
+		{
+			MethodName: "AppendReview",
+			Handler:    _Review_AppendReview_Handler,
+		},
+		{
+			MethodName: "AuditAppendReview",
+			Handler:    _Review_AuditAppendReview_Handler,
+		},
 		{
 			MethodName: "AuditReview",
 			Handler:    _Review_AuditReview_Handler,
@@ -367,6 +651,26 @@ var Review_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListReviewByStoreID",
 			Handler:    _Review_ListReviewByStoreID_Handler,
 		},
+		{
+			MethodName: "GetStoreReviewStats",
+			Handler:    _Review_GetStoreReviewStats_Handler,
+		},
+		{
+			MethodName: "GetSpuReviewStats",
+			Handler:    _Review_GetSpuReviewStats_Handler,
+		},
+		{
+			MethodName: "SearchReviews",
+			Handler:    _Review_SearchReviews_Handler,
+		},
+		{
+			MethodName: "CreateMediaUpload",
+			Handler:    _Review_CreateMediaUpload_Handler,
+		},
+		{
+			MethodName: "CompleteMediaUpload",
+			Handler:    _Review_CompleteMediaUpload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "review/v1/review.proto",
@@ -20,39 +20,71 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationReviewAppealReview = "/api.review.v1.Review/AppealReview"
+const OperationReviewAppendReview = "/api.review.v1.Review/AppendReview"
 const OperationReviewAuditAppeal = "/api.review.v1.Review/AuditAppeal"
+const OperationReviewAuditAppendReview = "/api.review.v1.Review/AuditAppendReview"
 const OperationReviewAuditReview = "/api.review.v1.Review/AuditReview"
+const OperationReviewCompleteMediaUpload = "/api.review.v1.Review/CompleteMediaUpload"
+const OperationReviewCreateMediaUpload = "/api.review.v1.Review/CreateMediaUpload"
 const OperationReviewCreateReview = "/api.review.v1.Review/CreateReview"
 const OperationReviewGetReview = "/api.review.v1.Review/GetReview"
+const OperationReviewGetSpuReviewStats = "/api.review.v1.Review/GetSpuReviewStats"
+const OperationReviewGetStoreReviewStats = "/api.review.v1.Review/GetStoreReviewStats"
 const OperationReviewListReviewByUserID = "/api.review.v1.Review/ListReviewByUserID"
 const OperationReviewReplyReview = "/api.review.v1.Review/ReplyReview"
+const OperationReviewSearchReviews = "/api.review.v1.Review/SearchReviews"
+const OperationReviewUpdateReview = "/api.review.v1.Review/UpdateReview"
 
 type ReviewHTTPServer interface {
 	// AppealReview B端申诉评价
 	AppealReview(context.Context, *AppealReviewRequest) (*AppealReviewReply, error)
+	// AppendReview C端追评,在评价创建后的一段时间内可以追加一次评价,追评需要单独审核
+	AppendReview(context.Context, *AppendReviewRequest) (*AppendReviewReply, error)
 	// AuditAppeal O端评价申诉审核
 	AuditAppeal(context.Context, *AuditAppealRequest) (*AuditAppealReply, error)
+	// AuditAppendReview O端审核追评
+	AuditAppendReview(context.Context, *AuditAppendReviewRequest) (*AuditAppendReviewReply, error)
 	// AuditReview O端审核评价
 	AuditReview(context.Context, *AuditReviewRequest) (*AuditReviewReply, error)
+	// CompleteMediaUpload C端/B端确认上传完成,校验文件的类型和大小,图片生成缩略图
+	CompleteMediaUpload(context.Context, *CompleteMediaUploadRequest) (*CompleteMediaUploadReply, error)
+	// CreateMediaUpload C端/B端申请上传图片或视频,返回预签名的上传地址,上传之后调用CompleteMediaUpload
+	CreateMediaUpload(context.Context, *CreateMediaUploadRequest) (*CreateMediaUploadReply, error)
 	// CreateReview C端创建评价
 	CreateReview(context.Context, *CreateReviewRequest) (*CreateReviewReply, error)
 	// GetReview C端获取评价详情
 	GetReview(context.Context, *GetReviewRequest) (*GetReviewReply, error)
+	// GetSpuReviewStats 商品(SPU)的评价统计(使用ES聚合)
+	GetSpuReviewStats(context.Context, *GetSpuReviewStatsRequest) (*GetSpuReviewStatsReply, error)
+	// GetStoreReviewStats 商家的评价统计:平均分、星级分布、有图率、回复率、好评率(使用ES聚合)
+	GetStoreReviewStats(context.Context, *GetStoreReviewStatsRequest) (*GetStoreReviewStatsReply, error)
 	// ListReviewByUserID C端查看userID下所有评价(使用ES)
 	ListReviewByUserID(context.Context, *ListReviewByUserIDRequest) (*ListReviewByUserIDReply, error)
 	// ReplyReview B端回复评价
 	ReplyReview(context.Context, *ReplyReviewRequest) (*ReplyReviewReply, error)
+	// SearchReviews C端/B端搜索评价:关键词全文检索+条件过滤,返回高亮片段(使用ES)
+	SearchReviews(context.Context, *SearchReviewsRequest) (*SearchReviewsReply, error)
+	// UpdateReview C端修改评价,只能修改待审核的评价,修改后重新审核
+	UpdateReview(context.Context, *UpdateReviewRequest) (*UpdateReviewReply, error)
 }
 
 func RegisterReviewHTTPServer(s *http.Server, srv ReviewHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/review", _Review_CreateReview0_HTTP_Handler(srv))
 	r.GET("/v1/review/{reviewID}", _Review_GetReview0_HTTP_Handler(srv))
+	r.PUT("/v1/review/{reviewID}", _Review_UpdateReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/{reviewID}/append", _Review_AppendReview0_HTTP_Handler(srv))
+	r.POST("/v1/review/append/audit", _Review_AuditAppendReview0_HTTP_Handler(srv))
 	r.POST("/v1/review/audit", _Review_AuditReview0_HTTP_Handler(srv))
 	r.POST("/v1/review/reply", _Review_ReplyReview0_HTTP_Handler(srv))
 	r.POST("/v1/review/appeal", _Review_AppealReview0_HTTP_Handler(srv))
 	r.POST("/v1/appeal/audit", _Review_AuditAppeal0_HTTP_Handler(srv))
 	r.GET("/v1/{userID}/reviews", _Review_ListReviewByUserID0_HTTP_Handler(srv))
+	r.GET("/v1/store/{storeID}/review/stats", _Review_GetStoreReviewStats0_HTTP_Handler(srv))
+	r.GET("/v1/spu/{spuID}/review/stats", _Review_GetSpuReviewStats0_HTTP_Handler(srv))
+	r.GET("/v1/reviews/search", _Review_SearchReviews0_HTTP_Handler(srv))
+	r.POST("/v1/media/upload", _Review_CreateMediaUpload0_HTTP_Handler(srv))
+	r.POST("/v1/media/upload/{mediaID}/complete", _Review_CompleteMediaUpload0_HTTP_Handler(srv))
 }
 
 func _Review_CreateReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
@@ -99,6 +131,78 @@ func _Review_GetReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context
 	}
 }
 
+func _Review_UpdateReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewUpdateReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateReview(ctx, req.(*UpdateReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_AppendReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AppendReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewAppendReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AppendReview(ctx, req.(*AppendReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AppendReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_AuditAppendReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AuditAppendReviewRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewAuditAppendReview)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AuditAppendReview(ctx, req.(*AuditAppendReviewRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AuditAppendReviewReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Review_AuditReview0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AuditReviewRequest
@@ -209,14 +313,132 @@ func _Review_ListReviewByUserID0_HTTP_Handler(srv ReviewHTTPServer) func(ctx htt
 	}
 }
 
+func _Review_GetStoreReviewStats0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetStoreReviewStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetStoreReviewStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetStoreReviewStats(ctx, req.(*GetStoreReviewStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetStoreReviewStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_GetSpuReviewStats0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSpuReviewStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewGetSpuReviewStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSpuReviewStats(ctx, req.(*GetSpuReviewStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSpuReviewStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_SearchReviews0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SearchReviewsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewSearchReviews)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SearchReviews(ctx, req.(*SearchReviewsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SearchReviewsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_CreateMediaUpload0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateMediaUploadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewCreateMediaUpload)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateMediaUpload(ctx, req.(*CreateMediaUploadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateMediaUploadReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Review_CompleteMediaUpload0_HTTP_Handler(srv ReviewHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CompleteMediaUploadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationReviewCompleteMediaUpload)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CompleteMediaUpload(ctx, req.(*CompleteMediaUploadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CompleteMediaUploadReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type ReviewHTTPClient interface {
 	AppealReview(ctx context.Context, req *AppealReviewRequest, opts ...http.CallOption) (rsp *AppealReviewReply, err error)
+	AppendReview(ctx context.Context, req *AppendReviewRequest, opts ...http.CallOption) (rsp *AppendReviewReply, err error)
 	AuditAppeal(ctx context.Context, req *AuditAppealRequest, opts ...http.CallOption) (rsp *AuditAppealReply, err error)
+	AuditAppendReview(ctx context.Context, req *AuditAppendReviewRequest, opts ...http.CallOption) (rsp *AuditAppendReviewReply, err error)
 	AuditReview(ctx context.Context, req *AuditReviewRequest, opts ...http.CallOption) (rsp *AuditReviewReply, err error)
+	CompleteMediaUpload(ctx context.Context, req *CompleteMediaUploadRequest, opts ...http.CallOption) (rsp *CompleteMediaUploadReply, err error)
+	CreateMediaUpload(ctx context.Context, req *CreateMediaUploadRequest, opts ...http.CallOption) (rsp *CreateMediaUploadReply, err error)
 	CreateReview(ctx context.Context, req *CreateReviewRequest, opts ...http.CallOption) (rsp *CreateReviewReply, err error)
 	GetReview(ctx context.Context, req *GetReviewRequest, opts ...http.CallOption) (rsp *GetReviewReply, err error)
+	GetSpuReviewStats(ctx context.Context, req *GetSpuReviewStatsRequest, opts ...http.CallOption) (rsp *GetSpuReviewStatsReply, err error)
+	GetStoreReviewStats(ctx context.Context, req *GetStoreReviewStatsRequest, opts ...http.CallOption) (rsp *GetStoreReviewStatsReply, err error)
 	ListReviewByUserID(ctx context.Context, req *ListReviewByUserIDRequest, opts ...http.CallOption) (rsp *ListReviewByUserIDReply, err error)
 	ReplyReview(ctx context.Context, req *ReplyReviewRequest, opts ...http.CallOption) (rsp *ReplyReviewReply, err error)
+	SearchReviews(ctx context.Context, req *SearchReviewsRequest, opts ...http.CallOption) (rsp *SearchReviewsReply, err error)
+	UpdateReview(ctx context.Context, req *UpdateReviewRequest, opts ...http.CallOption) (rsp *UpdateReviewReply, err error)
 }
 
 type ReviewHTTPClientImpl struct {
@@ -240,6 +462,19 @@ func (c *ReviewHTTPClientImpl) AppealReview(ctx context.Context, in *AppealRevie
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) AppendReview(ctx context.Context, in *AppendReviewRequest, opts ...http.CallOption) (*AppendReviewReply, error) {
+	var out AppendReviewReply
+	pattern := "/v1/review/{reviewID}/append"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAppendReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) AuditAppeal(ctx context.Context, in *AuditAppealRequest, opts ...http.CallOption) (*AuditAppealReply, error) {
 	var out AuditAppealReply
 	pattern := "/v1/appeal/audit"
@@ -253,6 +488,19 @@ func (c *ReviewHTTPClientImpl) AuditAppeal(ctx context.Context, in *AuditAppealR
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) AuditAppendReview(ctx context.Context, in *AuditAppendReviewRequest, opts ...http.CallOption) (*AuditAppendReviewReply, error) {
+	var out AuditAppendReviewReply
+	pattern := "/v1/review/append/audit"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewAuditAppendReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) AuditReview(ctx context.Context, in *AuditReviewRequest, opts ...http.CallOption) (*AuditReviewReply, error) {
 	var out AuditReviewReply
 	pattern := "/v1/review/audit"
@@ -266,6 +514,32 @@ func (c *ReviewHTTPClientImpl) AuditReview(ctx context.Context, in *AuditReviewR
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) CompleteMediaUpload(ctx context.Context, in *CompleteMediaUploadRequest, opts ...http.CallOption) (*CompleteMediaUploadReply, error) {
+	var out CompleteMediaUploadReply
+	pattern := "/v1/media/upload/{mediaID}/complete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCompleteMediaUpload))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) CreateMediaUpload(ctx context.Context, in *CreateMediaUploadRequest, opts ...http.CallOption) (*CreateMediaUploadReply, error) {
+	var out CreateMediaUploadReply
+	pattern := "/v1/media/upload"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewCreateMediaUpload))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...http.CallOption) (*CreateReviewReply, error) {
 	var out CreateReviewReply
 	pattern := "/v1/review"
@@ -292,6 +566,32 @@ func (c *ReviewHTTPClientImpl) GetReview(ctx context.Context, in *GetReviewReque
 	return &out, nil
 }
 
+func (c *ReviewHTTPClientImpl) GetSpuReviewStats(ctx context.Context, in *GetSpuReviewStatsRequest, opts ...http.CallOption) (*GetSpuReviewStatsReply, error) {
+	var out GetSpuReviewStatsReply
+	pattern := "/v1/spu/{spuID}/review/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetSpuReviewStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) GetStoreReviewStats(ctx context.Context, in *GetStoreReviewStatsRequest, opts ...http.CallOption) (*GetStoreReviewStatsReply, error) {
+	var out GetStoreReviewStatsReply
+	pattern := "/v1/store/{storeID}/review/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewGetStoreReviewStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *ReviewHTTPClientImpl) ListReviewByUserID(ctx context.Context, in *ListReviewByUserIDRequest, opts ...http.CallOption) (*ListReviewByUserIDReply, error) {
 	var out ListReviewByUserIDReply
 	pattern := "/v1/{userID}/reviews"
@@ -317,3 +617,29 @@ func (c *ReviewHTTPClientImpl) ReplyReview(ctx context.Context, in *ReplyReviewR
 	}
 	return &out, nil
 }
+
+func (c *ReviewHTTPClientImpl) SearchReviews(ctx context.Context, in *SearchReviewsRequest, opts ...http.CallOption) (*SearchReviewsReply, error) {
+	var out SearchReviewsReply
+	pattern := "/v1/reviews/search"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationReviewSearchReviews))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *ReviewHTTPClientImpl) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...http.CallOption) (*UpdateReviewReply, error) {
+	var out UpdateReviewReply
+	pattern := "/v1/review/{reviewID}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationReviewUpdateReview))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -3,8 +3,11 @@ package main
 import (
 	"flag"
 	"os"
+	"path/filepath"
 
+	"review-service/internal/biz"
 	"review-service/internal/conf"
+	"review-service/internal/data"
 	"review-service/pkg/snowflake"
 
 	"github.com/go-kratos/kratos/v2"
@@ -35,7 +38,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.Server, relay *data.OutboxRelay, cleaner *biz.MediaCleaner) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -45,11 +48,40 @@ func newApp(logger log.Logger, r registry.Registrar, gs *grpc.Server, hs *http.S
 		kratos.Server(
 			gs,
 			hs,
+			relay,   //outbox relay
+			cleaner, //清理过期的媒体
 		),
 		kratos.Registrar(r),
 	)
 }
 
+// configDir -conf可以是配置文件,也可以是目录
+func configDir(path string) string {
+	if fi, err := os.Stat(path); err == nil && !fi.IsDir() {
+		return filepath.Dir(path)
+	}
+	return path
+}
+
+// resolvePaths 配置中的相对路径相对于配置文件所在的目录,不受启动时工作目录的影响
+func resolvePaths(bc *conf.Bootstrap, dir string) {
+	resolve := func(p *string) {
+		if *p != "" && !filepath.IsAbs(*p) {
+			*p = filepath.Join(dir, *p)
+		}
+	}
+	if s := bc.GetData().GetObjectStore(); s != nil {
+		resolve(&s.Root)
+	}
+	if m := bc.GetReview().GetModeration(); m != nil {
+		resolve(&m.BlockWordsPath)
+		resolve(&m.ReviewWordsPath)
+	}
+	if m := bc.GetReview().GetMasking(); m != nil {
+		resolve(&m.WordsPath)
+	}
+}
+
 func main() {
 	flag.Parse()
 	logger := log.With(log.NewStdLogger(os.Stdout),
@@ -80,7 +112,8 @@ func main() {
 	if err := c.Scan(&rc); err != nil {
 		panic(err)
 	}
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Es, &rc, logger)
+	resolvePaths(&bc, configDir(flagconf))
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Es, bc.Kafka, bc.Auth, bc.Review, &rc, logger)
 	if err != nil {
 		panic(err)
 	}
@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, es *conf.ES, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, es *conf.ES, kafka *conf.Kafka, auth *conf.Auth, review *conf.Review, registry *conf.Registry, logger log.Logger) (*kratos.App, func(), error) {
 	registrar := server.NewRegistrar(registry)
 	db, err := data.NewDB(confData)
 	if err != nil {
@@ -34,16 +34,45 @@ func wireApp(confServer *conf.Server, confData *conf.Data, es *conf.ES, registry
 		return nil, nil, err
 	}
 	client := data.NewRdbClient(confData)
-	dataData, cleanup, err := data.NewData(db, typedClient, client, logger)
+	dataData, cleanup, err := data.NewData(confData, es, db, typedClient, client, logger)
 	if err != nil {
 		return nil, nil, err
 	}
 	reviewRepo := data.NewReviewRepo(dataData, logger)
-	reviewUsecase := biz.NewReviewUsecase(reviewRepo, logger)
+	moderator, err := biz.NewModerator(review, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	masker, err := biz.NewMasker(review, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	store, err := data.NewObjectStore(confData, dataData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	reviewUsecase, err := biz.NewReviewUsecase(review, reviewRepo, moderator, masker, store, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	reviewService := service.NewReviewService(reviewUsecase)
-	grpcServer := server.NewGRPCServer(confServer, reviewService, logger)
-	httpServer := server.NewHTTPServer(confServer, reviewService, logger)
-	app := newApp(logger, registrar, grpcServer, httpServer)
+	middleware, err := server.NewAuthMiddleware(auth)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	idempotencyStore := data.NewIdempotencyStore(dataData, logger)
+	grpcServer := server.NewGRPCServer(confServer, reviewService, middleware, idempotencyStore, logger)
+	health := data.NewHealth(dataData)
+	httpServer := server.NewHTTPServer(confServer, reviewService, middleware, idempotencyStore, health, store, logger)
+	writer := data.NewKafkaWriter(kafka)
+	outboxRelay := data.NewOutboxRelay(dataData, writer, kafka, logger)
+	mediaCleaner := biz.NewMediaCleaner(reviewUsecase, review, logger)
+	app := newApp(logger, registrar, grpcServer, httpServer, outboxRelay, mediaCleaner)
 	return app, func() {
 		cleanup()
 	}, nil
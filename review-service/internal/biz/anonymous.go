@@ -0,0 +1,43 @@
+package biz
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"review-service/internal/data/model"
+	"review-service/pkg/auth"
+	"strconv"
+)
+
+// ReviewUser 展示的评价人
+type ReviewUser struct {
+	UserID    int64  // 匿名评价对其他人为0
+	OrderID   int64  // 匿名评价对其他人为0,商家可以通过订单查到买家
+	Anonymous bool   // 是否匿名评价
+	Pseudonym string // 匿名评价展示的化名
+}
+
+// DisplayUser 返回展示评价人时使用的函数
+// 匿名评价只有评价人自己和运营能看到真实的用户ID和订单ID,其他人只能看到化名
+func (uc ReviewUsecase) DisplayUser(ctx context.Context) func(*model.ReviewInfo) ReviewUser {
+	id, _ := auth.FromContext(ctx)
+	return func(review *model.ReviewInfo) ReviewUser {
+		if review.Anonymous == 0 {
+			return ReviewUser{UserID: review.UserID, OrderID: review.OrderID}
+		}
+		u := ReviewUser{Anonymous: true, Pseudonym: uc.pseudonym(review.ReviewID)}
+		if id != nil && (id.Role == auth.RoleOperator || (id.Role == auth.RoleConsumer && id.UserID == review.UserID)) {
+			u.UserID, u.OrderID = review.UserID, review.OrderID
+		}
+		return u
+	}
+}
+
+// pseudonym 匿名评价的化名,由评价ID和密钥计算,同一条评价固定不变,也不能关联到同一个用户的其他评价
+// 使用HMAC,不知道密钥时无法由评价ID算出化名
+func (uc ReviewUsecase) pseudonym(reviewID int64) string {
+	mac := hmac.New(sha256.New, uc.pseudonymKey)
+	mac.Write([]byte(strconv.FormatInt(reviewID, 10)))
+	return "匿名用户" + hex.EncodeToString(mac.Sum(nil)[:3])
+}
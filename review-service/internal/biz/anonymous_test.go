@@ -0,0 +1,54 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"review-service/internal/data/model"
+	"review-service/pkg/auth"
+)
+
+func TestDisplayUser(t *testing.T) {
+	uc := ReviewUsecase{pseudonymKey: []byte("secret")}
+	review := &model.ReviewInfo{ReviewID: 1001, UserID: 7, OrderID: 9001, StoreID: 3, Anonymous: 1}
+	tests := []struct {
+		name string
+		id   *auth.Identity
+		want bool // 是否能看到真实的用户ID和订单ID
+	}{
+		{"merchant", &auth.Identity{Role: auth.RoleMerchant, StoreID: 3}, false},
+		{"other consumer", &auth.Identity{Role: auth.RoleConsumer, UserID: 8}, false},
+		{"author", &auth.Identity{Role: auth.RoleConsumer, UserID: 7}, true},
+		{"operator", &auth.Identity{Role: auth.RoleOperator}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := uc.DisplayUser(auth.NewContext(context.Background(), tt.id))(review)
+			if !u.Anonymous || u.Pseudonym == "" {
+				t.Fatalf("DisplayUser() = %+v, want anonymous with pseudonym", u)
+			}
+			if tt.want && (u.UserID != 7 || u.OrderID != 9001) {
+				t.Errorf("DisplayUser() = %+v, want real user and order", u)
+			}
+			if !tt.want && (u.UserID != 0 || u.OrderID != 0) {
+				t.Errorf("DisplayUser() = %+v, want user and order hidden", u)
+			}
+		})
+	}
+
+	// 化名对同一条评价固定,换了密钥就不同
+	if a, b := uc.pseudonym(1001), uc.pseudonym(1001); a != b {
+		t.Errorf("pseudonym() not stable: %s != %s", a, b)
+	}
+	other := ReviewUsecase{pseudonymKey: []byte("another")}
+	if uc.pseudonym(1001) == other.pseudonym(1001) {
+		t.Error("pseudonym() does not depend on the secret")
+	}
+
+	// 不匿名的评价原样展示
+	review.Anonymous = 0
+	u := uc.DisplayUser(auth.NewContext(context.Background(), &auth.Identity{Role: auth.RoleMerchant, StoreID: 3}))(review)
+	if u.Anonymous || u.UserID != 7 || u.OrderID != 9001 {
+		t.Errorf("DisplayUser() for public review = %+v", u)
+	}
+}
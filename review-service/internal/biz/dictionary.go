@@ -0,0 +1,107 @@
+package biz
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"review-service/pkg/sensitive"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 没有配置时,每30秒检查一次词典文件是否有修改
+const defaultReloadInterval = 30 * time.Second
+
+// Dictionary 从文件加载的词典,文件修改之后自动重新加载,不需要重启服务
+// 使用时按间隔检查文件的修改时间,重新加载失败时继续使用旧的词典
+type Dictionary struct {
+	path     string
+	interval time.Duration
+	log      *log.Helper
+
+	matcher   atomic.Pointer[sensitive.Matcher]
+	checkedAt atomic.Int64 // 上次检查文件的时间(unix纳秒)
+	mu        sync.Mutex   // 同一时间只有一个请求重新加载
+	modTime   time.Time
+}
+
+// NewDictionary 加载词典文件,没有配置路径时为空词典
+func NewDictionary(path string, interval time.Duration, logger log.Logger) (*Dictionary, error) {
+	if interval <= 0 {
+		interval = defaultReloadInterval
+	}
+	d := &Dictionary{path: path, interval: interval, log: log.NewHelper(logger)}
+	d.matcher.Store(sensitive.New(nil))
+	if path == "" {
+		return d, nil
+	}
+	if err := d.load(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Matcher 当前的词典
+func (d *Dictionary) Matcher() *sensitive.Matcher {
+	d.reload()
+	return d.matcher.Load()
+}
+
+// reload 到了检查间隔并且文件有修改时重新加载
+func (d *Dictionary) reload() {
+	now := time.Now().UnixNano()
+	last := d.checkedAt.Load()
+	if d.path == "" || now-last < int64(d.interval) || !d.checkedAt.CompareAndSwap(last, now) {
+		return
+	}
+	if err := d.load(); err != nil {
+		d.log.Errorf("reload dictionary %s failed, keep using the old one, err:%v", d.path, err)
+	}
+}
+
+func (d *Dictionary) load() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.checkedAt.Store(time.Now().UnixNano())
+	fi, err := os.Stat(d.path)
+	if err != nil {
+		return fmt.Errorf("stat dictionary %s failed, err:%w", d.path, err)
+	}
+	if fi.ModTime().Equal(d.modTime) {
+		return nil
+	}
+	words, err := loadWords(d.path)
+	if err != nil {
+		return err
+	}
+	d.matcher.Store(sensitive.New(words))
+	d.modTime = fi.ModTime()
+	d.log.Infof("load dictionary %s, %d words", d.path, len(words))
+	return nil
+}
+
+// loadWords 读取词典文件,每行一个词,忽略空行和#开头的注释
+func loadWords(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open dictionary %s failed, err:%w", path, err)
+	}
+	defer f.Close()
+	var words []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		w := strings.TrimSpace(sc.Text())
+		if w == "" || strings.HasPrefix(w, "#") {
+			continue
+		}
+		words = append(words, w)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read dictionary %s failed, err:%w", path, err)
+	}
+	return words, nil
+}
@@ -0,0 +1,67 @@
+package biz
+
+import "time"
+
+// 评价领域事件类型
+// 事件与状态变更在同一个事务中写入outbox表,再由relay投递到kafka,下游根据事件类型理解业务含义
+const (
+	EventReviewCreated  = "ReviewCreated"  // 用户创建评价
+	EventReviewAudited  = "ReviewAudited"  // 运营审核评价
+	EventReplyPosted    = "ReplyPosted"    // 商家回复评价
+	EventAppealFiled    = "AppealFiled"    // 商家提交(或修改)申诉
+	EventAppealResolved = "AppealResolved" // 运营处理申诉
+	EventReviewUpdated  = "ReviewUpdated"  // 用户修改待审核的评价
+	EventReviewAppended = "ReviewAppended" // 用户追评
+	EventAppendAudited  = "AppendAudited"  // 运营审核追评
+)
+
+// ReviewEvent 评价领域事件,序列化后作为kafka消息的value
+// 同一条评价的事件使用ReviewID作为消息的key,保证投递到同一个分区,消费者按顺序处理
+// relay是至少一次投递,消费者需要根据EventID去重
+type ReviewEvent struct {
+	EventID    int64       `json:"event_id,string"`
+	EventType  string      `json:"event_type"`
+	ReviewID   int64       `json:"review_id,string"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"` // 事件内容,不同的事件类型对应不同的结构
+}
+
+// ReviewAuditedData ReviewAudited事件的内容
+type ReviewAuditedData struct {
+	Status    int32  `json:"status"`
+	OpUser    string `json:"op_user"`
+	OpReason  string `json:"op_reason"`
+	OpRemarks string `json:"op_remarks"`
+	Version   int32  `json:"version"` // 审核后评价的版本号
+}
+
+// AppealResolvedData AppealResolved事件的内容
+type AppealResolvedData struct {
+	AppealID     int64  `json:"appeal_id,string"`
+	Status       int32  `json:"status"`
+	OpUser       string `json:"op_user"`
+	ReviewHidden bool   `json:"review_hidden"` // 申诉通过时评价会被隐藏
+}
+
+// ReviewUpdatedData ReviewUpdated事件的内容,修改后评价回到待审核
+type ReviewUpdatedData struct {
+	Score        int32  `json:"score"`
+	ServiceScore int32  `json:"service_score"`
+	ExpressScore int32  `json:"express_score"`
+	Content      string `json:"content"`
+	PicInfo      string `json:"pic_info"`
+	VideoInfo    string `json:"video_info"`
+	HasMedia     int32  `json:"has_media"`
+	Anonymous    int32  `json:"anonymous"`
+	Version      int32  `json:"version"` // 修改后评价的版本号
+}
+
+// AppendAuditedData AppendAudited事件的内容
+type AppendAuditedData struct {
+	AppendID  int64  `json:"append_id,string"`
+	Status    int32  `json:"status"`
+	OpUser    string `json:"op_user"`
+	OpReason  string `json:"op_reason"`
+	OpRemarks string `json:"op_remarks"`
+	Version   int32  `json:"version"` // 审核后追评的版本号
+}
@@ -0,0 +1,97 @@
+package biz
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/pkg/auth"
+	"review-service/pkg/sensitive"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 打码使用的字符
+const maskRune = '*'
+
+// Masker 展示评价时对敏感内容打码
+// 只作用于返回给调用方的数据,存储和ES中保存的仍然是原文
+type Masker struct {
+	enabled bool
+	words   *Dictionary
+	phone   bool
+}
+
+func NewMasker(c *conf.Review, logger log.Logger) (*Masker, error) {
+	mc := c.GetMasking()
+	if !mc.GetEnabled() {
+		return &Masker{}, nil
+	}
+	words, err := NewDictionary(mc.GetWordsPath(), c.GetDictReloadInterval().AsDuration(), logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Masker{enabled: true, words: words, phone: mc.GetMaskPhone()}, nil
+}
+
+// Mask 词典中的词整体打码,手机号中间4位打码
+func (m *Masker) Mask(text string) string {
+	if !m.enabled || text == "" {
+		return text
+	}
+	text = sensitive.Mask(text, m.words.Matcher().FindAll(text), maskRune)
+	if m.phone {
+		text = maskPhones(text)
+	}
+	return text
+}
+
+// maskPhones 按审核时使用的phonePattern查找手机号,打码为138****5678,分隔符保持不变
+// phonePattern两侧的分组会占用相邻的字符,每次从上一个手机号的末尾继续查找,相邻的手机号也能打码
+func maskPhones(text string) string {
+	var b strings.Builder
+	last := 0
+	for {
+		loc := phonePattern.FindStringSubmatchIndex(text[last:])
+		if loc == nil {
+			break
+		}
+		start, end := last+loc[4], last+loc[5]
+		b.WriteString(text[last:start])
+		b.WriteString(maskPhone(text[start:end]))
+		last = end
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// maskPhone 手机号的第4到7位数字打码
+func maskPhone(s string) string {
+	b := []byte(s)
+	n := 0
+	for i, c := range b {
+		if c < '0' || c > '9' {
+			continue
+		}
+		if n++; n >= 4 && n <= 7 {
+			b[i] = maskRune
+		}
+	}
+	return string(b)
+}
+
+// DisplayMask 返回展示评价内容时使用的打码函数
+// raw为true时返回原文,只有运营可以查看原文
+func (uc ReviewUsecase) DisplayMask(ctx context.Context, raw bool) (func(string) string, error) {
+	if !raw {
+		return uc.masker.Mask, nil
+	}
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if id.Role != auth.RoleOperator {
+		return nil, v1.ErrorPermissionDenied("只有运营可以查看评价原文")
+	}
+	return func(s string) string { return s }, nil
+}
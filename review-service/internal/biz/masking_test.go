@@ -0,0 +1,31 @@
+package biz
+
+import "testing"
+
+func TestMaskerMask(t *testing.T) {
+	m := &Masker{enabled: true, words: dictionary("垃圾", "某宝"), phone: true}
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"clean", "物流很快,包装完好", "物流很快,包装完好"},
+		{"word", "包装太垃圾了", "包装太**了"},
+		{"word with separators", "比某 宝贵", "比* *贵"},
+		{"phone", "电话13812345678", "电话138****5678"},
+		{"phone with separators", "电话138-1234-5678", "电话138-****-5678"},
+		{"adjacent phones", "13812345678 13912345678", "138****5678 139****5678"},
+		{"phones separated by punctuation", "13812345678,13912345678", "138****5678,139****5678"},
+		{"order number", "订单2024061812345678901", "订单2024061812345678901"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := m.Mask(tt.text); got != tt.want {
+				t.Errorf("Mask(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+	if got := (&Masker{}).Mask("太垃圾了"); got != "太垃圾了" {
+		t.Errorf("disabled Mask() = %q, want original text", got)
+	}
+}
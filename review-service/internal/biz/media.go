@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"encoding/json"
+	"fmt"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"strings"
+	"time"
+)
+
+// Media 评价、回复、申诉中的图片或视频
+// 以json数组的形式保存在pic_info(图片)、video_info(视频)列中
+type Media struct {
+	Type        int32  `json:"type"` // 取值同v1.MediaType
+	URL         string `json:"url,omitempty"`
+	ObjectKey   string `json:"object_key,omitempty"`
+	Width       int32  `json:"width,omitempty"`
+	Height      int32  `json:"height,omitempty"`
+	Duration    int32  `json:"duration,omitempty"` // 视频时长(秒),由调用方声明,没有从文件中校验
+	ContentHash string `json:"content_hash,omitempty"`
+	Size        int64  `json:"size,omitempty"` // 文件大小(字节)
+
+	ThumbnailURL string `json:"-"` // 图片缩略图的地址,展示时根据objectKey生成,不保存
+}
+
+// MediaInfo 编码之后保存到表中的媒体信息
+type MediaInfo struct {
+	PicInfo   string
+	VideoInfo string
+	HasMedia  int32   // 是否有图或视频:0否;1是
+	MediaIDs  []int64 // 通过上传流程上传的媒体,保存时在同一个事务中关联到评价
+}
+
+// pic_info、video_info列的长度,按字节校验,9张带缩略图和摘要的图片也放得下
+const mediaColumnSize = 4096
+
+// 没有配置时的媒体限制
+const (
+	defaultMaxImages        = 9
+	defaultMaxVideos        = 1
+	defaultMaxImageSize     = 10 << 20
+	defaultMaxVideoSize     = 100 << 20
+	defaultMaxVideoDuration = time.Minute
+)
+
+// mediaLimit 图片和视频的数量、大小限制
+type mediaLimit struct {
+	maxImages        int
+	maxVideos        int
+	maxImageSize     int64
+	maxVideoSize     int64
+	maxVideoDuration time.Duration
+}
+
+func newMediaLimit(c *conf.Review_MediaLimit) mediaLimit {
+	l := mediaLimit{
+		maxImages:        int(c.GetMaxImages()),
+		maxVideos:        int(c.GetMaxVideos()),
+		maxImageSize:     c.GetMaxImageSize(),
+		maxVideoSize:     c.GetMaxVideoSize(),
+		maxVideoDuration: c.GetMaxVideoDuration().AsDuration(),
+	}
+	if l.maxImages <= 0 {
+		l.maxImages = defaultMaxImages
+	}
+	if l.maxVideos <= 0 {
+		l.maxVideos = defaultMaxVideos
+	}
+	if l.maxImageSize <= 0 {
+		l.maxImageSize = defaultMaxImageSize
+	}
+	if l.maxVideoSize <= 0 {
+		l.maxVideoSize = defaultMaxVideoSize
+	}
+	if l.maxVideoDuration <= 0 {
+		l.maxVideoDuration = defaultMaxVideoDuration
+	}
+	return l
+}
+
+// encode 校验媒体,编码为pic_info、video_info列的内容
+// 没有传media时原样保存旧版本调用方传的picInfo/videoInfo字符串
+func (l mediaLimit) encode(media []*Media, picInfo, videoInfo string) (*MediaInfo, error) {
+	if len(media) == 0 {
+		info := &MediaInfo{PicInfo: picInfo, VideoInfo: videoInfo}
+		if len(picInfo) > mediaColumnSize || len(videoInfo) > mediaColumnSize {
+			return nil, v1.ErrorInvalidParam("媒体信息超过%d字节", mediaColumnSize)
+		}
+		if strings.TrimSpace(picInfo) != "" || strings.TrimSpace(videoInfo) != "" {
+			info.HasMedia = 1
+		}
+		return info, nil
+	}
+	var images, videos []*Media
+	for i, m := range media {
+		if m.URL == "" && m.ObjectKey == "" {
+			return nil, v1.ErrorInvalidParam("第%d个媒体缺少url或objectKey", i+1)
+		}
+		if reason := l.check(m); reason != "" {
+			return nil, v1.ErrorInvalidParam("第%d个媒体:%s", i+1, reason)
+		}
+		if m.Type == int32(v1.MediaType_MEDIA_TYPE_IMAGE) {
+			images = append(images, m)
+		} else {
+			videos = append(videos, m)
+		}
+	}
+	if len(images) > l.maxImages {
+		return nil, v1.ErrorInvalidParam("最多上传%d张图片", l.maxImages)
+	}
+	if len(videos) > l.maxVideos {
+		return nil, v1.ErrorInvalidParam("最多上传%d个视频", l.maxVideos)
+	}
+	info := &MediaInfo{HasMedia: 1}
+	var err error
+	if info.PicInfo, err = encodeMediaColumn(images); err != nil {
+		return nil, err
+	}
+	if info.VideoInfo, err = encodeMediaColumn(videos); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// check 校验单个媒体的类型、大小和时长,不符合时返回原因
+func (l mediaLimit) check(m *Media) string {
+	switch v1.MediaType(m.Type) {
+	case v1.MediaType_MEDIA_TYPE_IMAGE:
+		if m.Size > l.maxImageSize {
+			return fmt.Sprintf("图片不能超过%d字节", l.maxImageSize)
+		}
+	case v1.MediaType_MEDIA_TYPE_VIDEO:
+		if m.Size > l.maxVideoSize {
+			return fmt.Sprintf("视频不能超过%d字节", l.maxVideoSize)
+		}
+		if time.Duration(m.Duration)*time.Second > l.maxVideoDuration {
+			return fmt.Sprintf("视频不能超过%v", l.maxVideoDuration)
+		}
+	default:
+		return fmt.Sprintf("未知的媒体类型%d", m.Type)
+	}
+	return ""
+}
+
+// maxSize 媒体类型的大小上限
+func (l mediaLimit) maxSize(typ int32) int64 {
+	if typ == int32(v1.MediaType_MEDIA_TYPE_VIDEO) {
+		return l.maxVideoSize
+	}
+	return l.maxImageSize
+}
+
+func encodeMediaColumn(list []*Media) (string, error) {
+	if len(list) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(list)
+	if err != nil {
+		return "", err
+	}
+	if len(b) > mediaColumnSize {
+		return "", v1.ErrorInvalidParam("媒体信息超过%d字节,请减少数量或使用objectKey代替url", mediaColumnSize)
+	}
+	return string(b), nil
+}
+
+// DecodeMedia 解析pic_info、video_info列中的媒体信息
+// 新数据是Media的json数组;旧数据是自由格式的字符串,按逗号分隔的url处理
+func DecodeMedia(picInfo, videoInfo string) []*Media {
+	images := decodeMediaColumn(picInfo, v1.MediaType_MEDIA_TYPE_IMAGE)
+	return append(images, decodeMediaColumn(videoInfo, v1.MediaType_MEDIA_TYPE_VIDEO)...)
+}
+
+func decodeMediaColumn(s string, typ v1.MediaType) []*Media {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	if strings.HasPrefix(s, "[") {
+		var list []*Media
+		if err := json.Unmarshal([]byte(s), &list); err == nil {
+			return list
+		}
+	}
+	var list []*Media
+	for _, u := range strings.Split(s, ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			list = append(list, &Media{Type: int32(typ), URL: u})
+		}
+	}
+	return list
+}
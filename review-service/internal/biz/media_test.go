@@ -0,0 +1,74 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMediaLimitEncode(t *testing.T) {
+	l := newMediaLimit(nil)
+	image := func(url string) *Media { return &Media{Type: 1, URL: url, Size: 1 << 20} }
+	video := &Media{Type: 2, ObjectKey: "review/v.mp4", Duration: 30, Size: 20 << 20}
+	tests := []struct {
+		name      string
+		media     []*Media
+		pic       string
+		wantErr   bool
+		wantMedia int32
+	}{
+		{"empty", nil, "", false, 0},
+		{"legacy", nil, "http://a.jpg", false, 1},
+		{"image and video", []*Media{image("http://a.jpg"), video}, "ignored", false, 1},
+		{"no url", []*Media{{Type: 1}}, "", true, 0},
+		{"unknown type", []*Media{{URL: "http://a.jpg"}}, "", true, 0},
+		{"too many videos", []*Media{video, video}, "", true, 0},
+		{"image too large", []*Media{{Type: 1, URL: "http://a.jpg", Size: 11 << 20}}, "", true, 0},
+		{"video too long", []*Media{{Type: 2, URL: "http://v.mp4", Duration: 61}}, "", true, 0},
+		{"max images", nineImages(strings.Repeat("a", 100)), "", false, 1},
+		{"column overflow", nineImages(strings.Repeat("a", 500)), "", true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := l.encode(tt.media, tt.pic, "")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("encode() err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && info.HasMedia != tt.wantMedia {
+				t.Errorf("encode() HasMedia = %d, want %d", info.HasMedia, tt.wantMedia)
+			}
+		})
+	}
+}
+
+// nineImages 9张完整的图片信息
+func nineImages(url string) []*Media {
+	list := make([]*Media, 0, 9)
+	for i := 0; i < 9; i++ {
+		list = append(list, &Media{
+			Type:        1,
+			URL:         url,
+			ObjectKey:   "media/1/1234567890123456789.jpg",
+			Width:       4096,
+			Height:      3072,
+			ContentHash: strings.Repeat("f", 64),
+			Size:        10 << 20,
+		})
+	}
+	return list
+}
+
+func TestDecodeMedia(t *testing.T) {
+	info, err := newMediaLimit(nil).encode([]*Media{{Type: 1, URL: "http://a.jpg", Width: 100}, {Type: 2, ObjectKey: "v.mp4"}}, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := DecodeMedia(info.PicInfo, info.VideoInfo)
+	if len(got) != 2 || got[0].URL != "http://a.jpg" || got[0].Width != 100 || got[1].Type != 2 || got[1].ObjectKey != "v.mp4" {
+		t.Errorf("DecodeMedia() round trip = %+v", got)
+	}
+	// 旧数据按逗号分隔的url处理
+	got = DecodeMedia("http://a.jpg, http://b.jpg", "http://v.mp4")
+	if len(got) != 3 || got[1].URL != "http://b.jpg" || got[1].Type != 1 || got[2].Type != 2 {
+		t.Errorf("DecodeMedia() legacy = %+v", got)
+	}
+}
@@ -0,0 +1,122 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"review-service/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ModerationVerdict 自动审核的结论
+type ModerationVerdict int
+
+const (
+	VerdictReview ModerationVerdict = iota // 拿不准,交给运营人工审核
+	VerdictPass                            // 自动审核通过
+	VerdictReject                          // 明显违规,自动驳回
+)
+
+// ModerationResult 自动审核的结果
+type ModerationResult struct {
+	Verdict ModerationVerdict
+	Reason  string // 驳回或转人工的原因,自动审核的结论会写入op_reason
+}
+
+// Moderator 评价内容的自动审核,在运营人工审核之前执行
+// 内置基于词典和规则的实现,也可以替换为第三方内容安全服务
+type Moderator interface {
+	Moderate(ctx context.Context, content string) (*ModerationResult, error)
+}
+
+// 自动审核记录的操作人
+const moderatorOpUser = "system:moderation"
+
+// 没有配置时,同一个字符连续出现超过10次认为是灌水
+const defaultMaxRepeat = 10
+
+var (
+	urlPattern   = regexp.MustCompile(`(?i)(https?://|www\.)\S+|[a-z0-9-]+\.(com|cn|net|org|top|xyz|cc)\b`)
+	phonePattern = regexp.MustCompile(`(^|\D)(1[3-9]\d[\s-]?\d{4}[\s-]?\d{4})(\D|$)`) // 第2个分组是手机号本身
+	// 微信号、QQ号等联系方式
+	contactPattern = regexp.MustCompile(`(?i)(微信|vx|wx|v信|qq|扣扣)\s*[:：号]?\s*[a-z0-9_-]{5,}`)
+)
+
+// NewModerator 根据配置创建自动审核,没有开启时所有评价都交给运营人工审核
+func NewModerator(c *conf.Review, logger log.Logger) (Moderator, error) {
+	mc := c.GetModeration()
+	if !mc.GetEnabled() {
+		return manualModerator{}, nil
+	}
+	interval := c.GetDictReloadInterval().AsDuration()
+	block, err := NewDictionary(mc.GetBlockWordsPath(), interval, logger)
+	if err != nil {
+		return nil, err
+	}
+	review, err := NewDictionary(mc.GetReviewWordsPath(), interval, logger)
+	if err != nil {
+		return nil, err
+	}
+	maxRepeat := int(mc.GetMaxRepeat())
+	if maxRepeat <= 0 {
+		maxRepeat = defaultMaxRepeat
+	}
+	return &ruleModerator{
+		block:     block,
+		review:    review,
+		maxRepeat: maxRepeat,
+	}, nil
+}
+
+// manualModerator 不做自动审核,全部转人工
+type manualModerator struct{}
+
+func (manualModerator) Moderate(context.Context, string) (*ModerationResult, error) {
+	return &ModerationResult{Verdict: VerdictReview, Reason: "未开启自动审核"}, nil
+}
+
+// ruleModerator 内置的自动审核:敏感词词典+灌水、广告规则
+type ruleModerator struct {
+	block     *Dictionary // 违禁词,命中直接驳回
+	review    *Dictionary // 可疑词,命中转人工
+	maxRepeat int         // 同一个字符连续出现的上限,超过转人工
+}
+
+func (m *ruleModerator) Moderate(_ context.Context, content string) (*ModerationResult, error) {
+	// 1.明显违规的直接驳回
+	if hits := m.block.Matcher().FindAll(content); len(hits) > 0 {
+		return &ModerationResult{Verdict: VerdictReject, Reason: "包含违禁词:" + hits[0].Word}, nil
+	}
+	if urlPattern.MatchString(content) {
+		return &ModerationResult{Verdict: VerdictReject, Reason: "包含网址链接"}, nil
+	}
+	if phonePattern.MatchString(content) || contactPattern.MatchString(content) {
+		return &ModerationResult{Verdict: VerdictReject, Reason: "包含联系方式"}, nil
+	}
+	// 2.拿不准的转人工
+	if hits := m.review.Matcher().FindAll(content); len(hits) > 0 {
+		return &ModerationResult{Verdict: VerdictReview, Reason: "包含可疑词:" + hits[0].Word}, nil
+	}
+	if n := maxRun(content); n > m.maxRepeat {
+		return &ModerationResult{Verdict: VerdictReview, Reason: fmt.Sprintf("同一字符连续出现%d次", n)}, nil
+	}
+	return &ModerationResult{Verdict: VerdictPass, Reason: "自动审核通过"}, nil
+}
+
+// maxRun 同一个字符最多连续出现的次数
+func maxRun(s string) int {
+	var (
+		last      rune
+		run, most int
+	)
+	for _, r := range s {
+		if r == last {
+			run++
+		} else {
+			last, run = r, 1
+		}
+		most = max(most, run)
+	}
+	return most
+}
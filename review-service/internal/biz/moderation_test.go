@@ -0,0 +1,50 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"review-service/pkg/sensitive"
+)
+
+func TestRuleModerator(t *testing.T) {
+	m := &ruleModerator{
+		block:     dictionary("刷单", "好评返现"),
+		review:    dictionary("假货"),
+		maxRepeat: 5,
+	}
+	tests := []struct {
+		name    string
+		content string
+		want    ModerationVerdict
+	}{
+		{"clean", "物流很快,包装完好,味道不错", VerdictPass},
+		{"block word", "需要刷单的联系我", VerdictReject},
+		{"block word with separators", "好 评-返*现,五星好评", VerdictReject},
+		{"url", "更多优惠请看 https://example.com/xx", VerdictReject},
+		{"bare domain", "去shop-abc.com买更便宜", VerdictReject},
+		{"phone", "有问题打138 1234 5678", VerdictReject},
+		{"wechat", "加我微信:abc_12345 有惊喜", VerdictReject},
+		{"order number is not phone", "订单号2024061812345678901已收到", VerdictPass},
+		{"review word", "感觉像是假货,再观察一下", VerdictReview},
+		{"repeated chars", "好好好好好好好好好评", VerdictReview},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ret, err := m.Moderate(context.Background(), tt.content)
+			if err != nil {
+				t.Fatalf("Moderate() error = %v", err)
+			}
+			if ret.Verdict != tt.want {
+				t.Errorf("Moderate(%q) = %v(%s), want %v", tt.content, ret.Verdict, ret.Reason, tt.want)
+			}
+		})
+	}
+}
+
+// dictionary 不从文件加载的词典
+func dictionary(words ...string) *Dictionary {
+	d := &Dictionary{}
+	d.matcher.Store(sensitive.New(words))
+	return d
+}
@@ -1,38 +1,121 @@
 package biz
 
+import (
+	v1 "review-service/api/review/v1"
+	"time"
+)
+
 // ReplyParam 商家回复评价的参数
 type ReplyParam struct {
 	ReviewID  int64
-	StoreID   int64
+	StoreID   int64 // 回复的商家,由biz层从调用方身份中填充
 	Content   string
 	PicInfo   string
 	VideoInfo string
+	Media     []*Media // 结构化的图片和视频,不为空时忽略PicInfo、VideoInfo
 }
 
 // AuditParam 运营审核评价的参数
 type AuditParam struct {
 	ReviewID  int64
-	OpUser    string
+	OpUser    string // 操作人,由biz层从调用方身份中填充
 	OpReason  string
 	OpRemarks string
 	Status    int32
+	Version   *int32 // 期望的评价版本号(乐观锁),为nil时使用biz层读到的版本号
+	StoreID   int64  // 评价所属的商家,由biz层填充,用于让商家的评价缓存失效
 }
 
 // AppealParam 商家申诉评价的参数
 type AppealParam struct {
 	ReviewID  int64
-	StoreID   int64
+	StoreID   int64 // 申诉的商家,由biz层从调用方身份中填充
 	Reason    string
 	Content   string
 	PicInfo   string
 	VideoInfo string
+	Media     []*Media // 结构化的图片和视频,不为空时忽略PicInfo、VideoInfo
+	MediaIDs  []int64  // 需要关联到评价的媒体,由biz层填充
 	OpUser    string
+	Version   *int32 // 修改待审核申诉时期望的申诉版本号,为nil时使用data层读到的版本号
 }
 
 // AuditAppealParam O端审核商家申诉的参数
 type AuditAppealParam struct {
 	ReviewID int64
 	AppealID int64
-	OpUser   string
+	OpUser   string // 操作人,由biz层从调用方身份中填充
 	Status   int32
+	Version  *int32 // 期望的申诉版本号(乐观锁),为nil时使用biz层读到的版本号
+
+	ReviewVersion int32 // 申诉通过需要隐藏评价时,评价的版本号,由biz层填充
+	StoreID       int64 // 申诉所属的商家,由biz层填充,用于让商家的评价缓存失效
+}
+
+// UpdateParam 用户修改评价的参数
+type UpdateParam struct {
+	ReviewID     int64
+	Score        int32
+	ServiceScore int32
+	ExpressScore int32
+	Content      string
+	PicInfo      string
+	VideoInfo    string
+	Media        []*Media  // 结构化的图片和视频,不为空时忽略PicInfo、VideoInfo
+	HasMedia     int32     // 是否有图或视频,由biz层根据媒体信息填充
+	MediaIDs     []int64   // 需要关联到评价的媒体,由biz层填充
+	UnbindIDs    []int64   // 修改后不再引用、需要解除关联的媒体,由biz层填充
+	UnbindExpire time.Time // 解除关联的媒体的清理时间,由biz层填充
+	Anonymous    int32
+	Version      *int32 // 期望的评价版本号(乐观锁),为nil时使用biz层读到的版本号
+	StoreID      int64  // 评价所属的商家,由biz层填充,用于让商家的评价缓存失效
+}
+
+// AuditAppendParam 运营审核追评的参数
+type AuditAppendParam struct {
+	AppendID  int64
+	OpUser    string // 操作人,由biz层从调用方身份中填充
+	OpReason  string
+	OpRemarks string
+	Status    int32
+	Version   *int32 // 期望的追评版本号(乐观锁),为nil时使用biz层读到的版本号
+
+	ReviewID int64 // 追评所属的评价,由biz层填充
+	StoreID  int64 // 追评所属的商家,由biz层填充,用于让商家的评价缓存失效
+}
+
+// SearchParam 搜索评价的参数,指针类型的过滤条件为nil时表示不过滤
+type SearchParam struct {
+	Keyword   string // 在评价内容中检索的关键词,为空时只按条件过滤
+	StoreID   *int64
+	SpuID     *int64
+	SkuID     *int64
+	MinScore  *int32
+	MaxScore  *int32
+	HasMedia  *bool
+	HasReply  *bool
+	Anonymous *bool
+	Status    *int32
+	StartTime *time.Time // 创建时间范围[StartTime, EndTime)
+	EndTime   *time.Time
+	Sort      v1.SearchSort
+	Offset    int // 由biz层根据页码计算,PageToken不为空时忽略
+	Limit     int
+	PageToken string // 上一页返回的NextPageToken,使用search_after翻页
+}
+
+// PageParam 列表查询的分页参数
+// PageToken不为空时使用游标翻页(ES的search_after、MySQL按id的keyset),此时忽略Offset,深度翻页不会变慢
+type PageParam struct {
+	Offset    int
+	Limit     int
+	PageToken string // 上一页返回的NextPageToken,由data层生成和解析,对调用方不透明
+}
+
+// UploadParam 申请上传图片或视频的参数
+type UploadParam struct {
+	Type        int32 // 取值同v1.MediaType
+	ContentType string
+	Size        int64 // 文件大小(字节)
+	Duration    int32 // 视频时长(秒)
 }
@@ -2,46 +2,96 @@ package biz
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
 	"review-service/internal/data/model"
+	"review-service/pkg/auth"
+	"review-service/pkg/objectstore"
 	"review-service/pkg/snowflake"
-	"strings"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 type ReviewUsecase struct {
-	repo ReviewRepo  //biz层需要调用data层的ReviewRepo,同时为了确保biz层提供了对应的方法,biz层会定义一个接口等待data层的repo实现
-	log  *log.Helper //从上层链式传递下来的log helper,用来记录可能的跨层信息传递
+	repo         ReviewRepo    //biz层需要调用data层的ReviewRepo,同时为了确保biz层提供了对应的方法,biz层会定义一个接口等待data层的repo实现
+	log          *log.Helper   //从上层链式传递下来的log helper,用来记录可能的跨层信息传递
+	appendWindow time.Duration //评价创建之后多长时间内可以追评
+	moderator    Moderator     //人工审核之前的自动审核
+	masker       *Masker       //展示评价时对敏感内容打码
+	media        mediaLimit    //图片和视频的数量、大小限制
+	store        objectstore.Store
+	upload       uploadOptions //图片和视频的上传流程
+	pseudonymKey []byte        //匿名评价化名的HMAC密钥
 }
 
-func NewReviewUsecase(repo ReviewRepo, logger log.Logger) *ReviewUsecase {
-	return &ReviewUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+// 没有配置时,评价创建之后30天内可以追评
+const defaultAppendWindow = 30 * 24 * time.Hour
+
+func NewReviewUsecase(c *conf.Review, repo ReviewRepo, moderator Moderator, masker *Masker, store objectstore.Store, logger log.Logger) (*ReviewUsecase, error) {
+	window := c.GetAppendWindow().AsDuration()
+	if window <= 0 {
+		window = defaultAppendWindow
+	}
+	if c.GetPseudonymSecret() == "" {
+		return nil, errors.New("review: pseudonym_secret is required")
 	}
+	return &ReviewUsecase{
+		repo:         repo,
+		log:          log.NewHelper(logger),
+		appendWindow: window,
+		moderator:    moderator,
+		masker:       masker,
+		media:        newMediaLimit(c.GetMediaLimit()),
+		store:        store,
+		upload:       newUploadOptions(c.GetUpload()),
+		pseudonymKey: []byte(c.GetPseudonymSecret()),
+	}, nil
 }
 
 type ReviewRepo interface {
 	// 这里需要定义biz层要求data层repo需要实现的方法,以SaveReview方法为例
-	SaveReview(context.Context, *model.ReviewInfo) (*model.ReviewInfo, error)
+	// Save*、UpdateReview、AppealReview在同一个事务中把mediaIDs(MediaInfo.MediaIDs)关联到评价
+	// UpdateReview在同一个事务中解除修改后不再引用的媒体(UpdateParam.UnbindIDs)的关联
+	SaveReview(ctx context.Context, review *model.ReviewInfo, mediaIDs []int64) (*model.ReviewInfo, error)
 	GetReviewByOrderID(context.Context, int64) ([]*model.ReviewInfo, error)
 	GetReview(context.Context, int64) (*model.ReviewInfo, error)
-	SaveReply(context.Context, *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error)
+	GetAppeal(context.Context, int64) (*model.ReviewAppealInfo, error)
+	SaveReply(ctx context.Context, reply *model.ReviewReplyInfo, mediaIDs []int64) (*model.ReviewReplyInfo, error)
 	GetReviewReply(context.Context, int64) (*model.ReviewReplyInfo, error)
+	UpdateReview(context.Context, *UpdateParam) error
+	SaveAppend(ctx context.Context, appended *model.ReviewAppendInfo, mediaIDs []int64) (*model.ReviewAppendInfo, error)
+	GetReviewAppend(ctx context.Context, reviewID int64) (*model.ReviewAppendInfo, error)
+	GetAppend(ctx context.Context, appendID int64) (*model.ReviewAppendInfo, error)
+	AuditAppend(context.Context, *AuditAppendParam) error
 	AuditReview(context.Context, *AuditParam) error
 	AppealReview(context.Context, *AppealParam) (*model.ReviewAppealInfo, error)
 	AuditAppeal(context.Context, *AuditAppealParam) error
-	ListReviewByUserID(ctx context.Context, userID int64, offset, limit int) ([]*model.ReviewInfo, error)
-	ListReviewByStoreID(ctx context.Context, storeID int64, offset, limit int) ([]*MyReviewInfo, error)
+	ListReviewByUserID(ctx context.Context, userID int64, page *PageParam) (*ReviewPage, error)
+	ListReviewByStoreID(ctx context.Context, storeID int64, status *int32, page *PageParam) (*ReviewDocumentPage, error)
+	SearchReviews(context.Context, *SearchParam) (*SearchResult, error)
+	GetStoreReviewStats(ctx context.Context, storeID int64) (*ReviewStats, error)
+	GetSpuReviewStats(ctx context.Context, spuID int64) (*ReviewStats, error)
+	SaveMedia(context.Context, *model.ReviewMediaInfo) error
+	GetMedia(ctx context.Context, mediaID int64) (*model.ReviewMediaInfo, error)
+	GetMediaByKeys(ctx context.Context, objectKeys []string) ([]*model.ReviewMediaInfo, error)
+	CompleteMedia(context.Context, *model.ReviewMediaInfo) error
+	ListExpiredMedia(ctx context.Context, before time.Time, limit int) ([]*model.ReviewMediaInfo, error)
+	DeleteMedia(ctx context.Context, mediaID int64) (bool, error)
 }
 
 // biz层提供给service层的方法,创建评价方法
 // biz层需要实现具体的业务逻辑
-func (uc ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewInfo) (*model.ReviewInfo, error) {
+// media为结构化的图片和视频,为空时使用review中旧格式的PicInfo、VideoInfo
+func (uc ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewInfo, media []*Media) (*model.ReviewInfo, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] CreateReview,req:%#v", review)
+	// 评价人以token中的身份为准
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	review.UserID = id.UserID
 	// 1.参数校验
 	// 1.1查看用户是否已经该Order做了评价
 	reviews, err := uc.repo.GetReviewByOrderID(ctx, review.OrderID)
@@ -52,22 +102,267 @@ func (uc ReviewUsecase) CreateReview(ctx context.Context, review *model.ReviewIn
 		// 1.2如果用户已经对该Order进行了评价,则直接返回
 		return nil, v1.ErrorOrderReviewed("订单号:%d已做过评价", review.OrderID)
 	}
+	// 1.3新创建的评价只能处于待审核状态
+	if err := CheckReviewTransition(int32(v1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED), review.Status); err != nil {
+		return nil, err
+	}
 	// 2.生成reviewID(使用雪花算法生成)
 	reviewID := snowflake.GenID()
 	review.ReviewID = reviewID
+	// 2.1校验图片和视频,是否有图/视频以实际的媒体信息为准
+	info, err := uc.prepareMedia(ctx, reviewID, media, review.PicInfo, review.VideoInfo)
+	if err != nil {
+		return nil, err
+	}
+	review.PicInfo, review.VideoInfo, review.HasMedia = info.PicInfo, info.VideoInfo, info.HasMedia
 	// 3.查询订单和商品信息
 	// 实际场景中需要通过RPC调用B端查询商品信息,订单具体信息,这里不用实现
 	// 4.拼装数据入库
-	return uc.repo.SaveReview(ctx, review)
+	review, err = uc.repo.SaveReview(ctx, review, info.MediaIDs)
+	if err != nil {
+		return nil, err
+	}
+	// 5.自动审核
+	uc.autoAuditReview(ctx, review)
+	return review, nil
+}
+
+// moderate 自动审核内容,返回审核结果对应的状态
+// 需要人工审核、或者自动审核出错时返回false,内容保持待审核
+func (uc ReviewUsecase) moderate(ctx context.Context, content string) (int32, string, bool) {
+	ret, err := uc.moderator.Moderate(ctx, content)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("moderate content failed, wait for manual audit, err:%v", err)
+		return 0, "", false
+	}
+	switch ret.Verdict {
+	case VerdictPass:
+		return int32(v1.ReviewStatus_REVIEW_STATUS_APPROVED), ret.Reason, true
+	case VerdictReject:
+		return int32(v1.ReviewStatus_REVIEW_STATUS_REJECTED), ret.Reason, true
+	}
+	uc.log.WithContext(ctx).Infof("content needs manual audit, reason:%s", ret.Reason)
+	return 0, "", false
+}
+
+// autoAuditReview 对待审核的评价做自动审核,审核结果和运营审核一样写入评价并产生ReviewAudited事件
+// 自动审核失败不影响评价的提交,评价保持待审核,由运营人工审核
+func (uc ReviewUsecase) autoAuditReview(ctx context.Context, review *model.ReviewInfo) {
+	status, reason, ok := uc.moderate(ctx, review.Content)
+	if !ok || CheckReviewTransition(review.Status, status) != nil {
+		return
+	}
+	version := review.Version
+	err := uc.repo.AuditReview(ctx, &AuditParam{
+		ReviewID: review.ReviewID,
+		OpUser:   moderatorOpUser,
+		OpReason: reason,
+		Status:   status,
+		Version:  &version,
+		StoreID:  review.StoreID,
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("auto audit review:%d failed, wait for manual audit, err:%v", review.ReviewID, err)
+		return
+	}
+	review.Status, review.OpUser, review.OpReason, review.Version = status, moderatorOpUser, reason, version+1
 }
 
 /*
 GetReview方法 根据评价ID获取评价信息
-返回Review信息，需要传入ReviewID
+返回Review信息以及调用方能看到的追评，需要传入ReviewID
 */
-func (uc ReviewUsecase) GetReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
+func (uc ReviewUsecase) GetReview(ctx context.Context, reviewID int64) (*ReviewDetail, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] GetReview reviewID:%v", reviewID)
-	return uc.repo.GetReview(ctx, reviewID)
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	review, err := uc.repo.GetReview(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	// 审核通过之前(以及被隐藏之后)的评价只有评价人、所属商家和运营能看到,对其他人当作不存在
+	if review.Status != int32(v1.ReviewStatus_REVIEW_STATUS_APPROVED) && !canSeeUnapproved(id, review) {
+		return nil, v1.ErrorReviewNotFound("评价:%d不存在", reviewID)
+	}
+	appended, err := uc.repo.GetReviewAppend(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	return &ReviewDetail{ReviewInfo: review, Append: visibleAppend(id, review, appended)}, nil
+}
+
+// visibleAppend 审核通过之前的追评和评价一样,只有评价人、所属商家和运营能看到
+func visibleAppend(id *auth.Identity, review *model.ReviewInfo, appended *model.ReviewAppendInfo) *model.ReviewAppendInfo {
+	if appended == nil || appended.Status == int32(v1.ReviewStatus_REVIEW_STATUS_APPROVED) || (review != nil && canSeeUnapproved(id, review)) {
+		return appended
+	}
+	return nil
+}
+
+// UpdateReview 用户修改评价
+// 只有待审核的评价可以修改,修改后清空审核信息,重新等待审核
+func (uc ReviewUsecase) UpdateReview(ctx context.Context, param *UpdateParam) (int32, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] UpdateReview param:%#v", param)
+	id, err := caller(ctx)
+	if err != nil {
+		return 0, err
+	}
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return 0, err
+	}
+	// 只能修改自己的评价
+	if review.UserID != id.UserID {
+		return 0, v1.ErrorPermissionDenied("水平越权,评价:%d不是该用户的评价", param.ReviewID)
+	}
+	if review.Status != int32(v1.ReviewStatus_REVIEW_STATUS_PENDING) {
+		return 0, v1.ErrorIllegalReviewTransition("评价状态为%v,只有待审核的评价可以修改", v1.ReviewStatus(review.Status))
+	}
+	if param.Version, err = expectVersion(param.Version, review.Version); err != nil {
+		return 0, err
+	}
+	info, err := uc.prepareMedia(ctx, param.ReviewID, param.Media, param.PicInfo, param.VideoInfo)
+	if err != nil {
+		return 0, err
+	}
+	param.PicInfo, param.VideoInfo, param.HasMedia = info.PicInfo, info.VideoInfo, info.HasMedia
+	param.MediaIDs = info.MediaIDs
+	if param.UnbindIDs, err = uc.unboundMedia(ctx, review, info.MediaIDs); err != nil {
+		return 0, err
+	}
+	param.UnbindExpire = time.Now().Add(uc.upload.retention)
+	param.StoreID = review.StoreID
+	if err := uc.repo.UpdateReview(ctx, param); err != nil {
+		return 0, err
+	}
+	// 修改后的评价重新自动审核
+	review.Content = param.Content
+	review.Status = int32(v1.ReviewStatus_REVIEW_STATUS_PENDING)
+	review.Version = *param.Version + 1
+	uc.autoAuditReview(ctx, review)
+	return review.Version, nil
+}
+
+// AppendReview 用户追评
+// 评价创建之后appendWindow内可以追评一次,追评单独审核,不影响原评价的状态
+func (uc ReviewUsecase) AppendReview(ctx context.Context, appended *model.ReviewAppendInfo, media []*Media) (*model.ReviewAppendInfo, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] AppendReview req:%#v", appended)
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	review, err := uc.repo.GetReview(ctx, appended.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.UserID != id.UserID {
+		return nil, v1.ErrorPermissionDenied("水平越权,评价:%d不是该用户的评价", appended.ReviewID)
+	}
+	// 被驳回或隐藏的评价不能追评
+	if review.Status != int32(v1.ReviewStatus_REVIEW_STATUS_PENDING) && review.Status != int32(v1.ReviewStatus_REVIEW_STATUS_APPROVED) {
+		return nil, v1.ErrorIllegalReviewTransition("评价状态为%v,不能追评", v1.ReviewStatus(review.Status))
+	}
+	if time.Since(review.CreateAt) > uc.appendWindow {
+		return nil, v1.ErrorAppendWindowExpired("评价:%d已超过追评期限", appended.ReviewID)
+	}
+	exist, err := uc.repo.GetReviewAppend(ctx, appended.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if exist != nil {
+		return nil, v1.ErrorAlreadyAppended("评价:%d已追评过", appended.ReviewID)
+	}
+	// 新的追评和新评价一样进入待审核
+	if err := CheckReviewTransition(int32(v1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED), appended.Status); err != nil {
+		return nil, err
+	}
+	info, err := uc.prepareMedia(ctx, appended.ReviewID, media, appended.PicInfo, appended.VideoInfo)
+	if err != nil {
+		return nil, err
+	}
+	appended.PicInfo, appended.VideoInfo = info.PicInfo, info.VideoInfo
+	appended.AppendID = snowflake.GenID()
+	appended.UserID = id.UserID
+	appended.StoreID = review.StoreID
+	appended, err = uc.repo.SaveAppend(ctx, appended, info.MediaIDs)
+	if err != nil {
+		return nil, err
+	}
+	uc.autoAuditAppend(ctx, appended)
+	return appended, nil
+}
+
+// autoAuditAppend 对待审核的追评做自动审核,失败时追评保持待审核
+func (uc ReviewUsecase) autoAuditAppend(ctx context.Context, appended *model.ReviewAppendInfo) {
+	status, reason, ok := uc.moderate(ctx, appended.Content)
+	if !ok || CheckReviewTransition(appended.Status, status) != nil {
+		return
+	}
+	version := appended.Version
+	err := uc.repo.AuditAppend(ctx, &AuditAppendParam{
+		AppendID: appended.AppendID,
+		OpUser:   moderatorOpUser,
+		OpReason: reason,
+		Status:   status,
+		Version:  &version,
+		ReviewID: appended.ReviewID,
+		StoreID:  appended.StoreID,
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("auto audit append:%d failed, wait for manual audit, err:%v", appended.AppendID, err)
+		return
+	}
+	appended.Status, appended.OpUser, appended.OpReason, appended.Version = status, moderatorOpUser, reason, version+1
+}
+
+// AuditAppend 运营审核追评,追评的状态流转与评价相同
+func (uc ReviewUsecase) AuditAppend(ctx context.Context, param *AuditAppendParam) error {
+	uc.log.WithContext(ctx).Debugf("[biz] AuditAppend param:%v", param)
+	id, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	param.OpUser = id.Subject
+	appended, err := uc.repo.GetAppend(ctx, param.AppendID)
+	if err != nil {
+		return err
+	}
+	if err := CheckReviewTransition(appended.Status, param.Status); err != nil {
+		return err
+	}
+	if param.Version, err = expectVersion(param.Version, appended.Version); err != nil {
+		return err
+	}
+	param.ReviewID = appended.ReviewID
+	param.StoreID = appended.StoreID
+	return uc.repo.AuditAppend(ctx, param)
+}
+
+// canSeeUnapproved 调用方能否看到未审核通过的评价
+func canSeeUnapproved(id *auth.Identity, review *model.ReviewInfo) bool {
+	switch id.Role {
+	case auth.RoleOperator:
+		return true
+	case auth.RoleMerchant:
+		return id.StoreID == review.StoreID
+	case auth.RoleConsumer:
+		return id.UserID == review.UserID
+	}
+	return false
+}
+
+// canListUnapproved 调用方能否在列表、搜索结果中看到未审核通过的评价
+// 只有运营和查询自己店铺的商家可以,storeID为nil时表示不限商家
+func canListUnapproved(id *auth.Identity, storeID *int64) bool {
+	switch id.Role {
+	case auth.RoleOperator:
+		return true
+	case auth.RoleMerchant:
+		return storeID != nil && *storeID == id.StoreID
+	}
+	return false
 }
 
 /*
@@ -77,6 +372,17 @@ CreateReply 创建评价回复
 func (uc ReviewUsecase) CreateReply(ctx context.Context, param *ReplyParam) (*model.ReviewReplyInfo, error) {
 	// 调用data层创建一个用户评价的回复(商家回复用户)
 	uc.log.WithContext(ctx).Debugf("[biz] CreateReply param:%v", param)
+	// 回复的商家以token中的身份为准
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	param.StoreID = id.StoreID
+	info, err := uc.prepareMedia(ctx, param.ReviewID, param.Media, param.PicInfo, param.VideoInfo)
+	if err != nil {
+		return nil, err
+	}
+	param.PicInfo, param.VideoInfo = info.PicInfo, info.VideoInfo
 	// DTO->PO
 	reply := model.ReviewReplyInfo{
 		ReplyID:   snowflake.GenID(),
@@ -86,94 +392,260 @@ func (uc ReviewUsecase) CreateReply(ctx context.Context, param *ReplyParam) (*mo
 		PicInfo:   param.PicInfo,
 		VideoInfo: param.VideoInfo,
 	}
-	return uc.repo.SaveReply(ctx, &reply)
+	return uc.repo.SaveReply(ctx, &reply, info.MediaIDs)
 }
 
 // AuditReview 审核评价
 func (uc ReviewUsecase) AuditReview(ctx context.Context, param *AuditParam) error {
 	uc.log.WithContext(ctx).Debugf("[biz] AuditReveiw param:%v", param)
+	// 操作人以token中的身份为准
+	id, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	param.OpUser = id.Subject
+	// 1.校验评价状态能否流转到审核结果
+	review, err := uc.repo.GetReview(ctx, param.ReviewID)
+	if err != nil {
+		return err
+	}
+	if err := CheckReviewTransition(review.Status, param.Status); err != nil {
+		return err
+	}
+	if param.Version, err = expectVersion(param.Version, review.Version); err != nil {
+		return err
+	}
+	param.StoreID = review.StoreID
+	// 2.更新评价的审核信息(data层按版本号做CAS更新)
 	return uc.repo.AuditReview(ctx, param)
 }
 
 // AppealReview 申诉评价
 func (uc ReviewUsecase) AppealReview(ctx context.Context, param *AppealParam) (*model.ReviewAppealInfo, error) {
 	uc.log.WithContext(ctx).Debugf("[biz] AppealReview param:%v", param)
+	// 申诉的商家以token中的身份为准
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	param.StoreID = id.StoreID
+	info, err := uc.prepareMedia(ctx, param.ReviewID, param.Media, param.PicInfo, param.VideoInfo)
+	if err != nil {
+		return nil, err
+	}
+	param.PicInfo, param.VideoInfo = info.PicInfo, info.VideoInfo
+	param.MediaIDs = info.MediaIDs
 	return uc.repo.AppealReview(ctx, param)
 }
 
 // AudiAppeal 审核申诉
 func (uc ReviewUsecase) AuditAppeal(ctx context.Context, param *AuditAppealParam) error {
 	uc.log.WithContext(ctx).Debugf("[biz] AuditAppeal param:%v", param)
+	// 操作人以token中的身份为准
+	id, err := caller(ctx)
+	if err != nil {
+		return err
+	}
+	param.OpUser = id.Subject
+	// 1.校验申诉记录是否存在,以及申诉状态能否流转到审核结果
+	appeal, err := uc.repo.GetAppeal(ctx, param.AppealID)
+	if err != nil {
+		return err
+	}
+	if appeal.ReviewID != param.ReviewID {
+		return v1.ErrorAppealNotFound("评价:%d下不存在申诉:%d", param.ReviewID, param.AppealID)
+	}
+	if err := CheckAppealTransition(appeal.Status, param.Status); err != nil {
+		return err
+	}
+	if param.Version, err = expectVersion(param.Version, appeal.Version); err != nil {
+		return err
+	}
+	param.StoreID = appeal.StoreID
+	// 2.申诉通过时评价会被隐藏,同样需要校验评价的状态流转
+	if param.Status == int32(v1.AppealStatus_APPEAL_STATUS_APPROVED) {
+		review, err := uc.repo.GetReview(ctx, param.ReviewID)
+		if err != nil {
+			return err
+		}
+		if err := CheckReviewTransition(review.Status, int32(v1.ReviewStatus_REVIEW_STATUS_HIDDEN)); err != nil {
+			return err
+		}
+		param.ReviewVersion = review.Version
+	}
+	// 3.更新申诉及评价
 	return uc.repo.AuditAppeal(ctx, param)
 }
 
+// caller 获取调用方的身份,由server层的认证中间件放入ctx
+func caller(ctx context.Context) (*auth.Identity, error) {
+	id, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, v1.ErrorNeedLogin("请先登录")
+	}
+	return id, nil
+}
+
+// expectVersion 校验调用方期望的版本号与当前版本号是否一致
+// 调用方没有传期望版本号时,以当前读到的版本号作为CAS更新的条件
+func expectVersion(want *int32, current int32) (*int32, error) {
+	if want != nil && *want != current {
+		return nil, v1.ErrorConcurrentModification("记录已被修改,期望版本:%d,当前版本:%d,请刷新后重试", *want, current)
+	}
+	return &current, nil
+}
+
 // ListReviewByID 根据UserID分页查询评价
-func (uc ReviewUsecase) ListReviewByUserID(ctx context.Context, userID int64, page, size int) ([]*model.ReviewInfo, error) {
+func (uc ReviewUsecase) ListReviewByUserID(ctx context.Context, userID int64, page, size int, pageToken string) (*ReviewPage, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByID userID:%v", userID)
+	return uc.repo.ListReviewByUserID(ctx, userID, newPageParam(page, size, pageToken))
+}
+
+// ListReviewByStoreID 根据store商家ID进行分页评价查询
+func (uc ReviewUsecase) ListReviewByStoreID(ctx context.Context, storeID int64, page, size int, pageToken string) (*ReviewDocumentPage, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] ListReviewStoreID storeID:%v", storeID)
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 其他人只能看到审核通过的评价
+	var status *int32
+	if !canListUnapproved(id, &storeID) {
+		approved := int32(v1.ReviewStatus_REVIEW_STATUS_APPROVED)
+		status = &approved
+	}
+	ret, err := uc.repo.ListReviewByStoreID(ctx, storeID, status, newPageParam(page, size, pageToken))
+	if err != nil {
+		return nil, err
+	}
+	for _, doc := range ret.List {
+		doc.Append = visibleAppend(id, doc.ReviewInfo, doc.Append)
+	}
+	return ret, nil
+}
+
+// newPageParam 根据页码和每页条数计算分页参数
+func newPageParam(page, size int, pageToken string) *PageParam {
 	// 如果页码为负数、默认返回第一页
 	if page <= 0 {
 		page = 1
 	}
-	// 如果size不合规、默认使用10页
+	// 如果size不合规、默认每页记录条数10条
 	if size <= 0 || size > 50 {
 		size = 10
 	}
-	offset := (page - 1) * size
-	limit := size
-	uc.log.WithContext(ctx).Debugf("[biz] ListReviewByID userID:%v", userID)
-	return uc.repo.ListReviewByUserID(ctx, userID, offset, limit)
+	return &PageParam{
+		Offset:    (page - 1) * size,
+		Limit:     size,
+		PageToken: pageToken,
+	}
 }
 
-// ListReviewByStoreID 根据store商家ID进行分页评价查询
-func (uc ReviewUsecase) ListReviewByStoreID(ctx context.Context, storeID int64, page, size int) ([]*MyReviewInfo, error) {
-	// 如果页面不合规，默认页面为1
+// SearchReviews 搜索评价
+func (uc ReviewUsecase) SearchReviews(ctx context.Context, param *SearchParam, page, size int) (*SearchResult, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] SearchReviews param:%#v", param)
+	if param.MinScore != nil && param.MaxScore != nil && *param.MinScore > *param.MaxScore {
+		return nil, v1.ErrorInvalidParam("最低评分不能大于最高评分")
+	}
+	if param.StartTime != nil && param.EndTime != nil && !param.StartTime.Before(*param.EndTime) {
+		return nil, v1.ErrorInvalidParam("开始时间必须早于结束时间")
+	}
 	if page <= 0 {
 		page = 1
 	}
-	// 如果每页记录条数不合规，默认每页记录条数10条
 	if size <= 0 || size > 50 {
 		size = 10
 	}
-	offset := (page - 1) * size
-	limit := size
-	uc.log.WithContext(ctx).Debugf("[biz] ListReviewStoreID storeID:%v", storeID)
-	return uc.repo.ListReviewByStoreID(ctx, storeID, offset, limit)
+	param.Offset = (page - 1) * size
+	param.Limit = size
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 其他人只能搜索审核通过的评价
+	if !canListUnapproved(id, param.StoreID) {
+		approved := int32(v1.ReviewStatus_REVIEW_STATUS_APPROVED)
+		if param.Status != nil && *param.Status != approved {
+			return nil, v1.ErrorPermissionDenied("只能搜索审核通过的评价")
+		}
+		param.Status = &approved
+	}
+	ret, err := uc.repo.SearchReviews(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+	for _, hit := range ret.List {
+		hit.Append = visibleAppend(id, hit.ReviewInfo, hit.Append)
+	}
+	return ret, nil
+}
+
+// GetStoreReviewStats 商家的评价统计
+func (uc ReviewUsecase) GetStoreReviewStats(ctx context.Context, storeID int64) (*ReviewStats, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetStoreReviewStats storeID:%v", storeID)
+	return uc.repo.GetStoreReviewStats(ctx, storeID)
+}
+
+// GetSpuReviewStats 商品的评价统计
+func (uc ReviewUsecase) GetSpuReviewStats(ctx context.Context, spuID int64) (*ReviewStats, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] GetSpuReviewStats spuID:%v", spuID)
+	return uc.repo.GetSpuReviewStats(ctx, spuID)
 }
 
-// 解决时间的JSON解析问题
-type MyReviewInfo struct {
+// ReviewDetail 评价详情
+type ReviewDetail struct {
 	*model.ReviewInfo
-	CreateAt MyTime `json:"create_at"` // 创建时间,时间类型需要重写UnmarshalJSON方法
-	UpdateAt MyTime `json:"update_at"` // 创建时间,时间类型需要重写UnmarshalJSON方法
-	// DeleteAt     *time.Time `json:"delete_at"` // 删除时间
-	Anonymous    int32 `json:"anonymous,string"` // int32类型的字段需要，string 标记，标记这些数据是从json的string类型反序列化到int32
-	Score        int32 `json:"score,string"`
-	ServiceScore int32 `json:"service_score,string"`
-	ExpressScore int32 `json:"express_score,string"`
-	HasMedia     int32 `json:"has_media,string"`
-	Status       int32 `json:"status,string"`
-	IsDefault    int32 `json:"is_default,string"`
-	HasReply     int32 `json:"has_reply,string"`
-	ID           int64 `json:"id,string"`
-	Version      int32 `json:"version,string"`
-	ReviewID     int64 `json:"review_id,string"`
-	OrderID      int64 `json:"order_id,string"`
-	SkuID        int64 `json:"sku_id,string"`
-	SpuID        int64 `json:"spu_id,string"`
-	StoreID      int64 `json:"store_id,string"`
-	UserID       int64 `json:"user_id,string"`
-}
-
-type MyTime time.Time
-
-// MyTime类型的Unmarshal方法,json.unmarshal时会自动调用该方法
-func (t *MyTime) UnmarshalJSON(data []byte) error {
-	// data = "\"2023-12-17 14:20:18\""
-	s := strings.Trim(string(data), `"`)
-	fmt.Println("string:", s)
-	tmp, err := time.Parse("2006-01-02 15:04:05", s) //以这种形式解析
-	if err != nil {
-		return err
-	}
-	*t = MyTime(tmp)
-	return nil
+	Append *model.ReviewAppendInfo // 调用方能看到的追评,没有时为nil
 }
+
+// ReviewDocument ES中的评价文档
+// review-job按照索引模板写入有类型的值(数字、RFC3339格式的时间),可以直接反序列化到model中
+// 回复、申诉和追评合并在评价文档中,没有时为nil
+type ReviewDocument struct {
+	*model.ReviewInfo
+	Reply  *model.ReviewReplyInfo  `json:"reply"`
+	Appeal *model.ReviewAppealInfo `json:"appeal"`
+	Append *model.ReviewAppendInfo `json:"append"`
+}
+
+// ReviewPage 一页评价
+type ReviewPage struct {
+	List          []*model.ReviewInfo
+	Total         int64
+	NextPageToken string // 为空时表示没有下一页
+}
+
+// ReviewDocumentPage 一页从ES中查询的评价
+type ReviewDocumentPage struct {
+	List          []*ReviewDocument
+	Total         int64
+	NextPageToken string // 为空时表示没有下一页
+}
+
+// SearchResult 搜索评价的结果
+type SearchResult struct {
+	Total         int64 // 命中的总条数
+	List          []*SearchHit
+	NextPageToken string // 为空时表示没有下一页
+}
+
+// SearchHit 命中的评价以及评价内容中的高亮片段
+type SearchHit struct {
+	*ReviewDocument
+	Highlights []string
+}
+
+// ReviewStats 评价统计,只统计审核通过的评价
+type ReviewStats struct {
+	Count           int64    `json:"count"`
+	AvgScore        float64  `json:"avg_score"`
+	AvgServiceScore float64  `json:"avg_service_score"`
+	AvgExpressScore float64  `json:"avg_express_score"`
+	ScoreHistogram  [5]int64 `json:"score_histogram"` // 1~5星的评价数
+	MediaRatio      float64  `json:"media_ratio"`     // 有图/视频的评价占比
+	ReplyRatio      float64  `json:"reply_ratio"`     // 商家已回复的评价占比
+	GoodRate        float64  `json:"good_rate"`       // 好评率
+}
+
+// GoodScore 4星及以上算好评
+const GoodScore = 4
@@ -1,24 +0,0 @@
-package biz
-
-import "testing"
-
-func TestMyTime_UnmarshalJSON(t *testing.T) {
-	type args struct {
-		data []byte
-	}
-	tests := []struct {
-		name    string
-		tr      *MyTime
-		args    args
-		wantErr bool
-	}{
-		// TODO: Add test cases.
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			if err := tt.tr.UnmarshalJSON(tt.args.data); (err != nil) != tt.wantErr {
-				t.Errorf("MyTime.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
-			}
-		})
-	}
-}
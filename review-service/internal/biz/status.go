@@ -0,0 +1,64 @@
+package biz
+
+import (
+	v1 "review-service/api/review/v1"
+)
+
+// reviewTransitions 评价状态机,key为当前状态,value为允许流转到的目标状态
+// 未出现在表中的流转(例如隐藏后再审核、审核通过后退回待审核)都是非法的
+var reviewTransitions = map[v1.ReviewStatus][]v1.ReviewStatus{
+	// 创建评价,新评价只能进入待审核
+	v1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED: {v1.ReviewStatus_REVIEW_STATUS_PENDING},
+	// 待审核的评价可以被运营审核,也可以因商家申诉通过而被隐藏
+	v1.ReviewStatus_REVIEW_STATUS_PENDING: {
+		v1.ReviewStatus_REVIEW_STATUS_APPROVED,
+		v1.ReviewStatus_REVIEW_STATUS_REJECTED,
+		v1.ReviewStatus_REVIEW_STATUS_HIDDEN,
+	},
+	// 审核通过的评价只能因商家申诉通过而被隐藏
+	v1.ReviewStatus_REVIEW_STATUS_APPROVED: {v1.ReviewStatus_REVIEW_STATUS_HIDDEN},
+	// 审核不通过的评价允许运营复审通过
+	v1.ReviewStatus_REVIEW_STATUS_REJECTED: {v1.ReviewStatus_REVIEW_STATUS_APPROVED},
+	// 隐藏是终态
+	v1.ReviewStatus_REVIEW_STATUS_HIDDEN: {},
+}
+
+// appealTransitions 申诉状态机,申诉只能从待审核流转到通过或驳回
+var appealTransitions = map[v1.AppealStatus][]v1.AppealStatus{
+	v1.AppealStatus_APPEAL_STATUS_UNSPECIFIED: {v1.AppealStatus_APPEAL_STATUS_PENDING},
+	v1.AppealStatus_APPEAL_STATUS_PENDING: {
+		v1.AppealStatus_APPEAL_STATUS_APPROVED,
+		v1.AppealStatus_APPEAL_STATUS_REJECTED,
+	},
+	v1.AppealStatus_APPEAL_STATUS_APPROVED: {},
+	v1.AppealStatus_APPEAL_STATUS_REJECTED: {},
+}
+
+// CheckReviewTransition 校验评价状态能否从from流转到to
+// 所有修改评价状态的操作都需要先经过这里的校验
+func CheckReviewTransition(from, to int32) error {
+	target := v1.ReviewStatus(to)
+	if _, ok := v1.ReviewStatus_name[to]; !ok || target == v1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED {
+		return v1.ErrorInvalidStatus("未定义的评价状态:%d", to)
+	}
+	for _, s := range reviewTransitions[v1.ReviewStatus(from)] {
+		if s == target {
+			return nil
+		}
+	}
+	return v1.ErrorIllegalReviewTransition("评价状态不允许从%v变更为%v", v1.ReviewStatus(from), target)
+}
+
+// CheckAppealTransition 校验申诉状态能否从from流转到to
+func CheckAppealTransition(from, to int32) error {
+	target := v1.AppealStatus(to)
+	if _, ok := v1.AppealStatus_name[to]; !ok || target == v1.AppealStatus_APPEAL_STATUS_UNSPECIFIED {
+		return v1.ErrorInvalidStatus("未定义的申诉状态:%d", to)
+	}
+	for _, s := range appealTransitions[v1.AppealStatus(from)] {
+		if s == target {
+			return nil
+		}
+	}
+	return v1.ErrorIllegalAppealTransition("申诉状态不允许从%v变更为%v", v1.AppealStatus(from), target)
+}
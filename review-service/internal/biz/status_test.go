@@ -0,0 +1,62 @@
+package biz
+
+import (
+	"testing"
+
+	v1 "review-service/api/review/v1"
+)
+
+func TestCheckReviewTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    v1.ReviewStatus
+		to      v1.ReviewStatus
+		wantErr func(error) bool
+	}{
+		{"create", v1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED, v1.ReviewStatus_REVIEW_STATUS_PENDING, nil},
+		{"approve", v1.ReviewStatus_REVIEW_STATUS_PENDING, v1.ReviewStatus_REVIEW_STATUS_APPROVED, nil},
+		{"reject", v1.ReviewStatus_REVIEW_STATUS_PENDING, v1.ReviewStatus_REVIEW_STATUS_REJECTED, nil},
+		{"hide approved", v1.ReviewStatus_REVIEW_STATUS_APPROVED, v1.ReviewStatus_REVIEW_STATUS_HIDDEN, nil},
+		{"create approved", v1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED, v1.ReviewStatus_REVIEW_STATUS_APPROVED, v1.IsIllegalReviewTransition},
+		{"approved back to pending", v1.ReviewStatus_REVIEW_STATUS_APPROVED, v1.ReviewStatus_REVIEW_STATUS_PENDING, v1.IsIllegalReviewTransition},
+		{"re-audit hidden", v1.ReviewStatus_REVIEW_STATUS_HIDDEN, v1.ReviewStatus_REVIEW_STATUS_APPROVED, v1.IsIllegalReviewTransition},
+		{"unknown status", v1.ReviewStatus_REVIEW_STATUS_PENDING, 25, v1.IsInvalidStatus},
+		{"unspecified status", v1.ReviewStatus_REVIEW_STATUS_PENDING, v1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED, v1.IsInvalidStatus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckReviewTransition(int32(tt.from), int32(tt.to))
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("CheckReviewTransition() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !tt.wantErr(err) {
+				t.Errorf("CheckReviewTransition() error = %v, want reason mismatch", err)
+			}
+		})
+	}
+}
+
+func TestCheckAppealTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    v1.AppealStatus
+		to      v1.AppealStatus
+		wantErr func(error) bool
+	}{
+		{"approve", v1.AppealStatus_APPEAL_STATUS_PENDING, v1.AppealStatus_APPEAL_STATUS_APPROVED, nil},
+		{"reject", v1.AppealStatus_APPEAL_STATUS_PENDING, v1.AppealStatus_APPEAL_STATUS_REJECTED, nil},
+		{"re-audit", v1.AppealStatus_APPEAL_STATUS_APPROVED, v1.AppealStatus_APPEAL_STATUS_REJECTED, v1.IsIllegalAppealTransition},
+		{"unknown status", v1.AppealStatus_APPEAL_STATUS_PENDING, 40, v1.IsInvalidStatus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAppealTransition(int32(tt.from), int32(tt.to))
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("CheckAppealTransition() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !tt.wantErr(err) {
+				t.Errorf("CheckAppealTransition() error = %v, want reason mismatch", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,535 @@
+package biz
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // 注册gif解码器
+	"image/jpeg"
+	_ "image/png" // 注册png解码器
+	"io"
+	"mime"
+	"net/http"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/pkg/auth"
+	"review-service/pkg/objectstore"
+	"review-service/pkg/snowflake"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 媒体的状态
+const (
+	MediaStatusPending  = 10 // 待上传
+	MediaStatusUploaded = 20 // 已上传,还没有关联评价
+	MediaStatusBound    = 30 // 已关联评价,跟随评价保存,不再清理
+)
+
+// 上传的文件在对象存储中的key前缀,缩略图的key为原图的key加上thumbnailSuffix
+const (
+	mediaKeyPrefix  = "media/"
+	thumbnailSuffix = "_thumb"
+)
+
+// 没有配置时的上传参数
+const (
+	defaultUploadExpire    = 15 * time.Minute
+	defaultUploadRetention = 24 * time.Hour
+	defaultThumbnailSize   = 320
+	defaultCleanupInterval = 10 * time.Minute
+	defaultMaxPixels       = 40_000_000
+)
+
+// 每次清理的条数
+const cleanupBatchSize = 100
+
+// 允许上传的文件类型,确认上传时以从文件内容识别出的类型为准
+var uploadContentTypes = map[string]v1.MediaType{
+	"image/jpeg": v1.MediaType_MEDIA_TYPE_IMAGE,
+	"image/png":  v1.MediaType_MEDIA_TYPE_IMAGE,
+	"image/gif":  v1.MediaType_MEDIA_TYPE_IMAGE,
+	"video/mp4":  v1.MediaType_MEDIA_TYPE_VIDEO,
+	"video/webm": v1.MediaType_MEDIA_TYPE_VIDEO,
+}
+
+// uploadOptions 上传流程的参数
+type uploadOptions struct {
+	expire          time.Duration
+	retention       time.Duration
+	thumbnailSize   int
+	cleanupInterval time.Duration
+	maxPixels       int64
+}
+
+func newUploadOptions(c *conf.Review_Upload) uploadOptions {
+	o := uploadOptions{
+		expire:          c.GetExpire().AsDuration(),
+		retention:       c.GetRetention().AsDuration(),
+		thumbnailSize:   int(c.GetThumbnailSize()),
+		cleanupInterval: c.GetCleanupInterval().AsDuration(),
+		maxPixels:       c.GetMaxPixels(),
+	}
+	if o.expire <= 0 {
+		o.expire = defaultUploadExpire
+	}
+	if o.retention <= 0 {
+		o.retention = defaultUploadRetention
+	}
+	if o.thumbnailSize <= 0 {
+		o.thumbnailSize = defaultThumbnailSize
+	}
+	if o.cleanupInterval <= 0 {
+		o.cleanupInterval = defaultCleanupInterval
+	}
+	if o.maxPixels <= 0 {
+		o.maxPixels = defaultMaxPixels
+	}
+	return o
+}
+
+// MediaUpload 申请上传的结果
+type MediaUpload struct {
+	MediaID   int64
+	ObjectKey string
+	*objectstore.PresignedRequest
+}
+
+// CreateMediaUpload 申请上传图片或视频,返回预签名的上传地址
+func (uc ReviewUsecase) CreateMediaUpload(ctx context.Context, param *UploadParam) (*MediaUpload, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CreateMediaUpload param:%#v", param)
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contentType, _, err := mime.ParseMediaType(param.ContentType)
+	if err != nil || uploadContentTypes[contentType] != v1.MediaType(param.Type) {
+		return nil, v1.ErrorMediaRejected("不支持的文件类型:%s", param.ContentType)
+	}
+	if reason := uc.media.check(&Media{Type: param.Type, Size: param.Size, Duration: param.Duration}); reason != "" {
+		return nil, v1.ErrorMediaRejected("%s", reason)
+	}
+	mediaID := snowflake.GenID()
+	key := mediaKeyPrefix + strconv.FormatInt(mediaID, 10)
+	req, err := uc.store.PresignPut(ctx, key, objectstore.PutOptions{
+		ContentType: contentType,
+		MaxSize:     uc.media.maxSize(param.Type),
+		Expires:     uc.upload.expire,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = uc.repo.SaveMedia(ctx, &model.ReviewMediaInfo{
+		MediaID:     mediaID,
+		ObjectKey:   key,
+		Uploader:    uploader(id),
+		Type:        param.Type,
+		ContentType: contentType,
+		Size:        param.Size,
+		Duration:    param.Duration,
+		Status:      MediaStatusPending,
+		// 上传地址过期之前开始的上传可能在过期之后才结束,多留一个有效期用来确认上传
+		ExpireAt: req.ExpireAt.Add(uc.upload.expire),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MediaUpload{MediaID: mediaID, ObjectKey: key, PresignedRequest: req}, nil
+}
+
+// CompleteMediaUpload 确认上传完成
+// 从文件内容识别类型并校验大小,计算sha256,图片生成缩略图;不符合要求的文件直接删除
+// 视频不解析容器格式,时长使用申请上传时调用方声明的值,只用于展示和时长上限的校验,不能作为可信的数据使用
+func (uc ReviewUsecase) CompleteMediaUpload(ctx context.Context, mediaID int64) (*Media, error) {
+	uc.log.WithContext(ctx).Debugf("[biz] CompleteMediaUpload mediaID:%d", mediaID)
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, err := uc.repo.GetMedia(ctx, mediaID)
+	if err != nil {
+		return nil, err
+	}
+	if m.Uploader != uploader(id) {
+		return nil, v1.ErrorPermissionDenied("媒体:%d不是该调用方上传的", mediaID)
+	}
+	// 重复确认直接返回
+	if m.Status != MediaStatusPending {
+		return uc.mediaOf(m), nil
+	}
+	if time.Now().After(m.ExpireAt) {
+		return nil, v1.ErrorMediaUploadExpired("媒体:%d的上传地址已过期,请重新申请", mediaID)
+	}
+	info, err := uc.store.Head(ctx, m.ObjectKey)
+	if errors.Is(err, objectstore.ErrNotFound) {
+		return nil, v1.ErrorMediaNotUploaded("媒体:%d还没有上传", mediaID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if info.Size > uc.media.maxSize(m.Type) {
+		uc.removeObject(ctx, m.ObjectKey)
+		return nil, v1.ErrorMediaRejected("文件大小%d字节超过上限", info.Size)
+	}
+	probe, err := uc.probeObject(ctx, m)
+	if err != nil {
+		uc.removeObject(ctx, m.ObjectKey)
+		return nil, err
+	}
+	if probe.thumbnail != nil {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, probe.thumbnail, &jpeg.Options{Quality: 80}); err != nil {
+			return nil, err
+		}
+		m.ThumbnailKey = m.ObjectKey + thumbnailSuffix
+		if err := uc.store.Put(ctx, m.ThumbnailKey, &buf, "image/jpeg"); err != nil {
+			return nil, err
+		}
+	}
+	m.Status = MediaStatusUploaded
+	m.Size = info.Size
+	m.ContentType = probe.contentType
+	m.Width, m.Height = probe.width, probe.height
+	m.ContentHash = probe.hash
+	m.ExpireAt = time.Now().Add(uc.upload.retention)
+	if err := uc.repo.CompleteMedia(ctx, m); err != nil {
+		return nil, err
+	}
+	return uc.mediaOf(m), nil
+}
+
+// mediaProbe 从文件内容中识别出的信息
+type mediaProbe struct {
+	contentType   string
+	width, height int32
+	hash          string
+	thumbnail     image.Image
+}
+
+func (uc ReviewUsecase) probeObject(ctx context.Context, m *model.ReviewMediaInfo) (*mediaProbe, error) {
+	rc, err := uc.store.Get(ctx, m.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return probeMedia(rc, m.Type, uc.upload)
+}
+
+// probeMedia 识别文件类型,必须与申请上传时的媒体类型一致;图片解码后生成缩略图
+// 图片先只解析头部的宽高,像素数超过上限时不解码,解码占用的内存与像素数成正比,和文件大小无关
+func probeMedia(r io.Reader, typ int32, o uploadOptions) (*mediaProbe, error) {
+	h := sha256.New()
+	br := bufio.NewReader(io.TeeReader(r, h))
+	head, _ := br.Peek(512)
+	p := &mediaProbe{contentType: http.DetectContentType(head)}
+	if t, ok := uploadContentTypes[p.contentType]; !ok || int32(t) != typ {
+		return nil, v1.ErrorMediaRejected("文件内容的类型%s与申请上传的类型不符", p.contentType)
+	}
+	if typ == int32(v1.MediaType_MEDIA_TYPE_IMAGE) {
+		// 图片的大小在确认上传时已经校验过,整个读到内存中,解析头部和解码各读一次
+		data, err := io.ReadAll(br)
+		if err != nil {
+			return nil, err
+		}
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, v1.ErrorMediaRejected("图片无法解码:%v", err)
+		}
+		if int64(cfg.Width)*int64(cfg.Height) > o.maxPixels {
+			return nil, v1.ErrorMediaRejected("图片%dx%d超过%d像素", cfg.Width, cfg.Height, o.maxPixels)
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, v1.ErrorMediaRejected("图片无法解码:%v", err)
+		}
+		b := img.Bounds()
+		p.width, p.height = int32(b.Dx()), int32(b.Dy())
+		p.thumbnail = thumbnail(img, o.thumbnailSize)
+	}
+	if _, err := io.Copy(io.Discard, br); err != nil {
+		return nil, err
+	}
+	p.hash = hex.EncodeToString(h.Sum(nil))
+	return p, nil
+}
+
+// thumbnail 按比例缩小到最大边长不超过size,每个像素取对应区域的平均值,透明部分铺白底
+func thumbnail(src image.Image, size int) image.Image {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	tw, th := w, h
+	if w > size || h > size {
+		if w >= h {
+			tw, th = size, max(1, h*size/w)
+		} else {
+			tw, th = max(1, w*size/h), size
+		}
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0, y1 := b.Min.Y+y*h/th, b.Min.Y+max((y+1)*h/th, y*h/th+1)
+		for x := 0; x < tw; x++ {
+			x0, x1 := b.Min.X+x*w/tw, b.Min.X+max((x+1)*w/tw, x*w/tw+1)
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r, g, bl, a, n = r+uint64(cr), g+uint64(cg), bl+uint64(cb), a+uint64(ca), n+1
+				}
+			}
+			// RGBA()返回的是预乘alpha的值,加上透明部分的白色
+			white := 0xffff - a/n
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r/n + white),
+				G: uint16(g/n + white),
+				B: uint16(bl/n + white),
+				A: 0xffff,
+			})
+		}
+	}
+	return dst
+}
+
+// removeObject 删除不符合要求的文件,失败时由过期清理兜底
+func (uc ReviewUsecase) removeObject(ctx context.Context, key string) {
+	if err := uc.store.Delete(ctx, key); err != nil {
+		uc.log.WithContext(ctx).Errorf("delete object %s failed, err:%v", key, err)
+	}
+}
+
+// prepareMedia 校验评价、追评、回复、申诉中的媒体,编码为pic_info、video_info列的内容
+// 通过上传流程上传的媒体(传了objectKey)必须是调用方自己上传并确认完成的,以上传时识别出的信息为准
+// 返回的MediaIDs由data层在保存的事务中关联到评价,保存失败时不会留下关联了评价的媒体
+func (uc ReviewUsecase) prepareMedia(ctx context.Context, reviewID int64, media []*Media, picInfo, videoInfo string) (*MediaInfo, error) {
+	media, mediaIDs, err := uc.resolveMedia(ctx, reviewID, media)
+	if err != nil {
+		return nil, err
+	}
+	info, err := uc.media.encode(media, picInfo, videoInfo)
+	if err != nil {
+		return nil, err
+	}
+	info.MediaIDs = mediaIDs
+	return info, nil
+}
+
+// resolveMedia 把传了objectKey的媒体替换为上传时记录的信息,返回需要关联的媒体ID
+func (uc ReviewUsecase) resolveMedia(ctx context.Context, reviewID int64, media []*Media) ([]*Media, []int64, error) {
+	var keys []string
+	for _, m := range media {
+		if m.ObjectKey != "" {
+			keys = append(keys, m.ObjectKey)
+		}
+	}
+	if len(keys) == 0 {
+		return media, nil, nil
+	}
+	id, err := caller(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	records, err := uc.repo.GetMediaByKeys(ctx, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	byKey := make(map[string]*model.ReviewMediaInfo, len(records))
+	for _, r := range records {
+		byKey[r.ObjectKey] = r
+	}
+	ret := make([]*Media, 0, len(media))
+	var mediaIDs []int64
+	seen := make(map[string]bool, len(keys))
+	for i, m := range media {
+		if m.ObjectKey == "" {
+			ret = append(ret, m)
+			continue
+		}
+		r, ok := byKey[m.ObjectKey]
+		switch {
+		case !ok:
+			return nil, nil, v1.ErrorMediaNotFound("第%d个媒体:%s不存在", i+1, m.ObjectKey)
+		case seen[m.ObjectKey]:
+			return nil, nil, v1.ErrorInvalidParam("第%d个媒体:%s重复", i+1, m.ObjectKey)
+		case r.Uploader != uploader(id):
+			return nil, nil, v1.ErrorPermissionDenied("第%d个媒体:%s不是该调用方上传的", i+1, m.ObjectKey)
+		case r.Status == MediaStatusPending:
+			return nil, nil, v1.ErrorMediaNotUploaded("第%d个媒体:%s还没有确认上传完成", i+1, m.ObjectKey)
+		case r.Status == MediaStatusBound && r.ReviewID != reviewID:
+			return nil, nil, v1.ErrorMediaAlreadyUsed("第%d个媒体:%s已关联其他评价", i+1, m.ObjectKey)
+		}
+		seen[m.ObjectKey] = true
+		// 大小和sha256保存在媒体表中,pic_info、video_info中只保存展示需要的信息
+		ret = append(ret, &Media{
+			Type:      r.Type,
+			ObjectKey: r.ObjectKey,
+			Width:     r.Width,
+			Height:    r.Height,
+			Duration:  r.Duration,
+		})
+		mediaIDs = append(mediaIDs, r.MediaID)
+	}
+	return ret, mediaIDs, nil
+}
+
+// unboundMedia 修改评价后不再引用的媒体,由data层在保存的事务中解除关联,保留期过后由MediaCleaner清理
+// 只处理评价原来引用的、关联在这条评价上的媒体;追评中还在引用的不解除
+func (uc ReviewUsecase) unboundMedia(ctx context.Context, review *model.ReviewInfo, mediaIDs []int64) ([]int64, error) {
+	var keys []string
+	for _, m := range DecodeMedia(review.PicInfo, review.VideoInfo) {
+		if m.ObjectKey != "" {
+			keys = append(keys, m.ObjectKey)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	keep := make(map[int64]bool, len(mediaIDs))
+	for _, mediaID := range mediaIDs {
+		keep[mediaID] = true
+	}
+	appended, err := uc.repo.GetReviewAppend(ctx, review.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	inAppend := make(map[string]bool)
+	if appended != nil {
+		for _, m := range DecodeMedia(appended.PicInfo, appended.VideoInfo) {
+			inAppend[m.ObjectKey] = true
+		}
+	}
+	records, err := uc.repo.GetMediaByKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	var ret []int64
+	for _, r := range records {
+		if r.Status == MediaStatusBound && r.ReviewID == review.ReviewID && !keep[r.MediaID] && !inAppend[r.ObjectKey] {
+			ret = append(ret, r.MediaID)
+		}
+	}
+	return ret, nil
+}
+
+// DisplayMedia 解析pic_info、video_info列,为上传流程上传的媒体生成访问地址和缩略图地址
+func (uc ReviewUsecase) DisplayMedia(picInfo, videoInfo string) []*Media {
+	list := DecodeMedia(picInfo, videoInfo)
+	for _, m := range list {
+		if m.URL == "" && strings.HasPrefix(m.ObjectKey, mediaKeyPrefix) {
+			m.URL = uc.store.URL(m.ObjectKey)
+			if m.Type == int32(v1.MediaType_MEDIA_TYPE_IMAGE) {
+				m.ThumbnailURL = uc.store.URL(m.ObjectKey + thumbnailSuffix)
+			}
+		}
+	}
+	return list
+}
+
+func (uc ReviewUsecase) mediaOf(m *model.ReviewMediaInfo) *Media {
+	ret := &Media{
+		Type:        m.Type,
+		URL:         uc.store.URL(m.ObjectKey),
+		ObjectKey:   m.ObjectKey,
+		Width:       m.Width,
+		Height:      m.Height,
+		Duration:    m.Duration,
+		ContentHash: m.ContentHash,
+		Size:        m.Size,
+	}
+	if m.ThumbnailKey != "" {
+		ret.ThumbnailURL = uc.store.URL(m.ThumbnailKey)
+	}
+	return ret
+}
+
+// CleanupMedia 清理过期没有上传、或者上传之后一直没有关联评价的媒体,返回实际删除的条数
+// 查询之后刚好被关联的媒体不会删除,也不计入返回值,避免MediaCleaner一直重复查询同一批记录
+func (uc ReviewUsecase) CleanupMedia(ctx context.Context, limit int) (int, error) {
+	list, err := uc.repo.ListExpiredMedia(ctx, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, m := range list {
+		// 先删除记录(只删除没有关联评价的),避免删除文件时刚好被关联
+		deleted, err := uc.repo.DeleteMedia(ctx, m.MediaID)
+		if err != nil {
+			return 0, err
+		}
+		if !deleted {
+			continue
+		}
+		n++
+		uc.removeObject(ctx, m.ObjectKey)
+		if m.ThumbnailKey != "" {
+			uc.removeObject(ctx, m.ThumbnailKey)
+		}
+	}
+	return n, nil
+}
+
+// uploader 媒体的上传者,用户按用户ID、商家按商家ID区分
+func uploader(id *auth.Identity) string {
+	switch id.Role {
+	case auth.RoleConsumer:
+		return fmt.Sprintf("%s:%d", id.Role, id.UserID)
+	case auth.RoleMerchant:
+		return fmt.Sprintf("%s:%d", id.Role, id.StoreID)
+	}
+	return fmt.Sprintf("%s:%s", id.Role, id.Subject)
+}
+
+// MediaCleaner 定时清理过期的媒体,实现Transport.Server接口
+// 清理按记录做CAS删除,多个实例同时运行也不会误删已关联评价的媒体
+type MediaCleaner struct {
+	uc       *ReviewUsecase
+	interval time.Duration
+	log      *log.Helper
+}
+
+func NewMediaCleaner(uc *ReviewUsecase, c *conf.Review, logger log.Logger) *MediaCleaner {
+	return &MediaCleaner{
+		uc:       uc,
+		interval: newUploadOptions(c.GetUpload()).cleanupInterval,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Start kratos程序启动之后会调用的方法,ctx在程序退出时会被取消
+func (c *MediaCleaner) Start(ctx context.Context) error {
+	c.log.Debug("MediaCleaner start....")
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		// 一直清理直到没有过期的媒体
+		for {
+			n, err := c.uc.CleanupMedia(ctx, cleanupBatchSize)
+			if err != nil {
+				c.log.Errorf("cleanup expired media failed, err:%v", err)
+				break
+			}
+			if n < cleanupBatchSize {
+				break
+			}
+		}
+	}
+}
+
+func (c *MediaCleaner) Stop(context.Context) error {
+	c.log.Debug("MediaCleaner stop....")
+	return nil
+}
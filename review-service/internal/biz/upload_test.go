@@ -0,0 +1,195 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"image"
+	"image/color"
+	"image/png"
+	"reflect"
+	"review-service/internal/data/model"
+	"review-service/pkg/objectstore"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func TestProbeMedia(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 800, 400))
+	for y := 0; y < 400; y++ {
+		for x := 0; x < 800; x++ {
+			src.Set(x, y, color.NRGBA{R: 255, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	o := newUploadOptions(nil)
+	p, err := probeMedia(bytes.NewReader(data), 1, o)
+	if err != nil {
+		t.Fatalf("probeMedia() err = %v", err)
+	}
+	sum := sha256.Sum256(data)
+	if p.contentType != "image/png" || p.width != 800 || p.height != 400 || p.hash != hex.EncodeToString(sum[:]) {
+		t.Errorf("probeMedia() = %s %dx%d %s", p.contentType, p.width, p.height, p.hash)
+	}
+	if b := p.thumbnail.Bounds(); b.Dx() != 320 || b.Dy() != 160 {
+		t.Errorf("thumbnail size = %dx%d, want 320x160", b.Dx(), b.Dy())
+	}
+	if r, g, _, _ := p.thumbnail.At(10, 10).RGBA(); r>>8 != 255 || g>>8 != 0 {
+		t.Errorf("thumbnail color = %v, want red", p.thumbnail.At(10, 10))
+	}
+
+	// 申请上传的是视频,实际是图片
+	if _, err := probeMedia(bytes.NewReader(data), 2, o); err == nil {
+		t.Error("probeMedia() with mismatched type want error")
+	}
+	// 不在白名单中的类型
+	if _, err := probeMedia(bytes.NewReader([]byte("<html><body>hi</body></html>")), 1, o); err == nil {
+		t.Error("probeMedia() with html want error")
+	}
+	// 像素数超过上限时不解码
+	o.maxPixels = 800*400 - 1
+	if _, err := probeMedia(bytes.NewReader(data), 1, o); err == nil {
+		t.Error("probeMedia() over max pixels want error")
+	}
+}
+
+func TestProbeMediaBomb(t *testing.T) {
+	// 只有头部的gif,声明的尺寸是65535x65535,完整解码需要4G像素
+	bomb := []byte("GIF89a\xff\xff\xff\xff\x00\x00\x00")
+	_, err := probeMedia(bytes.NewReader(bomb), 1, newUploadOptions(nil))
+	if err == nil || !strings.Contains(err.Error(), "65535x65535") {
+		t.Errorf("probeMedia() err = %v, want rejected by max pixels", err)
+	}
+}
+
+func TestThumbnailTransparent(t *testing.T) {
+	// 透明的图片缩小后铺白底
+	src := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	got := thumbnail(src, 320)
+	if b := got.Bounds(); b.Dx() != 10 || b.Dy() != 10 {
+		t.Fatalf("thumbnail size = %dx%d, want unchanged 10x10", b.Dx(), b.Dy())
+	}
+	if r, g, b, _ := got.At(5, 5).RGBA(); r != 0xffff || g != 0xffff || b != 0xffff {
+		t.Errorf("thumbnail color = %v, want white", got.At(5, 5))
+	}
+}
+
+// mediaRepo 只实现媒体相关方法的ReviewRepo,调用其他方法时panic
+type mediaRepo struct {
+	ReviewRepo
+	media    []*model.ReviewMediaInfo
+	appended *model.ReviewAppendInfo
+	bound    map[int64]bool // 查询之后、删除之前被关联的媒体
+}
+
+func (r *mediaRepo) GetMediaByKeys(_ context.Context, keys []string) ([]*model.ReviewMediaInfo, error) {
+	var ret []*model.ReviewMediaInfo
+	for _, m := range r.media {
+		for _, k := range keys {
+			if m.ObjectKey == k {
+				ret = append(ret, m)
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (r *mediaRepo) GetReviewAppend(context.Context, int64) (*model.ReviewAppendInfo, error) {
+	return r.appended, nil
+}
+
+func (r *mediaRepo) ListExpiredMedia(_ context.Context, _ time.Time, limit int) ([]*model.ReviewMediaInfo, error) {
+	if len(r.media) > limit {
+		return r.media[:limit], nil
+	}
+	return r.media, nil
+}
+
+func (r *mediaRepo) DeleteMedia(_ context.Context, mediaID int64) (bool, error) {
+	return !r.bound[mediaID], nil
+}
+
+// recordStore 记录删除的对象
+type recordStore struct {
+	objectstore.Store
+	deleted []string
+}
+
+func (s *recordStore) Delete(_ context.Context, key string) error {
+	s.deleted = append(s.deleted, key)
+	return nil
+}
+
+func TestUnboundMedia(t *testing.T) {
+	const reviewID = 100
+	pic, err := encodeMediaColumn([]*Media{
+		{Type: 1, ObjectKey: "review/a.jpg"},
+		{Type: 1, ObjectKey: "review/b.jpg"},
+		{Type: 1, ObjectKey: "review/c.jpg"},
+		{Type: 1, ObjectKey: "review/d.jpg"},
+		{Type: 1, URL: "http://example.com/e.jpg"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	appendPic, err := encodeMediaColumn([]*Media{{Type: 1, ObjectKey: "review/c.jpg"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := &mediaRepo{
+		media: []*model.ReviewMediaInfo{
+			{MediaID: 1, ObjectKey: "review/a.jpg", Status: MediaStatusBound, ReviewID: reviewID},
+			{MediaID: 2, ObjectKey: "review/b.jpg", Status: MediaStatusBound, ReviewID: reviewID},
+			{MediaID: 3, ObjectKey: "review/c.jpg", Status: MediaStatusBound, ReviewID: reviewID},
+			{MediaID: 4, ObjectKey: "review/d.jpg", Status: MediaStatusBound, ReviewID: 200},
+		},
+		appended: &model.ReviewAppendInfo{ReviewID: reviewID, PicInfo: appendPic},
+	}
+	uc := ReviewUsecase{repo: repo, log: log.NewHelper(log.DefaultLogger)}
+	review := &model.ReviewInfo{ReviewID: reviewID, PicInfo: pic}
+	// a仍然引用;c在追评中引用;d关联在其他评价上;只有b需要解除关联
+	got, err := uc.unboundMedia(context.Background(), review, []int64{1})
+	if err != nil {
+		t.Fatalf("unboundMedia() err = %v", err)
+	}
+	if want := []int64{2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unboundMedia() = %v, want %v", got, want)
+	}
+	// 旧格式的pic_info中没有objectKey,不需要查询
+	got, err = uc.unboundMedia(context.Background(), &model.ReviewInfo{ReviewID: reviewID, PicInfo: "http://example.com/e.jpg"}, nil)
+	if err != nil || got != nil {
+		t.Errorf("unboundMedia() with legacy pic_info = %v, %v, want nil", got, err)
+	}
+}
+
+func TestCleanupMedia(t *testing.T) {
+	repo := &mediaRepo{
+		media: []*model.ReviewMediaInfo{
+			{MediaID: 1, ObjectKey: "review/a.jpg", ThumbnailKey: "review/a.jpg_thumb"},
+			{MediaID: 2, ObjectKey: "review/b.jpg"},
+		},
+		bound: map[int64]bool{2: true},
+	}
+	store := &recordStore{}
+	uc := ReviewUsecase{repo: repo, store: store, log: log.NewHelper(log.DefaultLogger)}
+	// 刚好被关联的媒体不删除,也不计入清理的条数,否则MediaCleaner会一直重复清理同一批
+	n, err := uc.CleanupMedia(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("CleanupMedia() err = %v", err)
+	}
+	if n != 1 {
+		t.Errorf("CleanupMedia() = %d, want 1", n)
+	}
+	if want := []string{"review/a.jpg", "review/a.jpg_thumb"}; !reflect.DeepEqual(store.deleted, want) {
+		t.Errorf("deleted objects = %v, want %v", store.deleted, want)
+	}
+}
@@ -0,0 +1,169 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"review-service/internal/conf"
+	"sync"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/go-redis/redis"
+)
+
+// errUnavailable 依赖不可用:熔断器处于打开状态,或者调用失败并计入了熔断统计
+// 调用方通过errors.Is判断是否需要降级
+var errUnavailable = errors.New("unavailable")
+
+// 熔断器的状态
+const (
+	breakerClosed   = "closed"    // 正常
+	breakerOpen     = "open"      // 熔断中,请求直接失败
+	breakerHalfOpen = "half_open" // 熔断超时,放一个请求过去探测
+)
+
+// breaker 按连续失败次数熔断
+// 连续失败threshold次后打开,openTimeout之后放一个探测请求,探测成功则关闭,失败则继续熔断
+type breaker struct {
+	name        string
+	threshold   int
+	openTimeout time.Duration
+	healthy     func(error) bool // 返回true的错误(例如redis.Nil)不算失败
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newBreaker(name string, cfg *conf.Data_Breaker, healthy func(error) bool) *breaker {
+	b := &breaker{
+		name:        name,
+		threshold:   int(cfg.GetFailureThreshold()),
+		openTimeout: cfg.GetOpenTimeout().AsDuration(),
+		healthy:     healthy,
+		state:       breakerClosed,
+	}
+	// 没有配置时使用默认值
+	if b.threshold <= 0 {
+		b.threshold = 5
+	}
+	if b.openTimeout <= 0 {
+		b.openTimeout = 10 * time.Second
+	}
+	return b
+}
+
+// redisHealthy key不存在不算失败
+func redisHealthy(err error) bool {
+	return err == nil || errors.Is(err, redis.Nil)
+}
+
+// esHealthy ES返回4xx(例如查询语句有误)说明ES本身是正常的
+func esHealthy(err error) bool {
+	var e *types.ElasticsearchError
+	return err == nil || (errors.As(err, &e) && e.Status < http.StatusInternalServerError)
+}
+
+// do 熔断器允许时执行fn
+// 返回的错误计入熔断统计时包装为errUnavailable,其他错误原样返回
+func (b *breaker) do(fn func() error) error {
+	if !b.allow() {
+		return fmt.Errorf("%s circuit breaker is open: %w", b.name, errUnavailable)
+	}
+	err := fn()
+	if b.healthy(err) {
+		b.success()
+		return err
+	}
+	b.failure()
+	return fmt.Errorf("%s %w: %w", b.name, errUnavailable, err)
+}
+
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.probing = true
+		return true
+	case breakerHalfOpen:
+		// 同时只放一个探测请求
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state = breakerClosed
+	b.failures = 0
+	b.probing = false
+}
+
+func (b *breaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	b.probing = false
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+	}
+}
+
+func (b *breaker) State() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// 服务的运行模式
+const (
+	modeNormal   = "normal"   // 先查Redis缓存,没有再查ES
+	modeNoCache  = "no_cache" // Redis不可用,直接查ES
+	modeFallback = "fallback" // ES不可用,商家评价列表查MySQL
+	modeDBOnly   = "db_only"  // Redis和ES都不可用
+)
+
+// Health 以json格式返回Redis、ES熔断器的状态以及当前的运行模式,供监控和负载均衡探测
+// 降级模式下服务仍然可用,始终返回200
+type Health struct {
+	data *Data
+}
+
+func NewHealth(data *Data) *Health {
+	return &Health{data: data}
+}
+
+func (h *Health) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	redisState, esState := h.data.redisBreaker.State(), h.data.esBreaker.State()
+	mode := modeNormal
+	switch {
+	case redisState != breakerClosed && esState != breakerClosed:
+		mode = modeDBOnly
+	case esState != breakerClosed:
+		mode = modeFallback
+	case redisState != breakerClosed:
+		mode = modeNoCache
+	}
+	body, _ := json.Marshal(map[string]string{
+		"mode":  mode,
+		"redis": redisState,
+		"es":    esState,
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
@@ -0,0 +1,255 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"review-service/internal/conf"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// 商家、商品评价缓存的代数(generation)
+// 商家的评价列表、评价统计,商品的评价统计的缓存key中都带有当前代数,评价发生变化时代数加一,旧代数的缓存不会再被读到,等待过期即可
+// review-job写入ES后也会对同一个key加一,两边的key格式需要保持一致
+const (
+	storeGenerationKey = "review:gen:%d"
+	spuGenerationKey   = "review:gen:spu:%d"
+)
+
+// storeGeneration 获取商家当前的缓存代数,没有时为0
+func (r reviewRepo) storeGeneration(storeID int64) (int64, error) {
+	return r.generation(fmt.Sprintf(storeGenerationKey, storeID))
+}
+
+// spuGeneration 获取商品当前的缓存代数,没有时为0
+// 商品的代数只由review-job在评价写入ES后加一
+func (r reviewRepo) spuGeneration(spuID int64) (int64, error) {
+	return r.generation(fmt.Sprintf(spuGenerationKey, spuID))
+}
+
+func (r reviewRepo) generation(key string) (int64, error) {
+	var gen int64
+	err := r.data.redisBreaker.do(func() (err error) {
+		gen, err = r.data.rdb.Get(key).Int64()
+		return err
+	})
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return gen, err
+}
+
+// bumpStoreGeneration 让商家的评价缓存失效
+// 只记录日志不返回错误,数据已经写入成功,缓存最多在过期之前是旧的
+func (r reviewRepo) bumpStoreGeneration(storeID int64) {
+	err := r.data.redisBreaker.do(func() error {
+		return r.data.rdb.Incr(fmt.Sprintf(storeGenerationKey, storeID)).Err()
+	})
+	if err != nil {
+		r.log.Errorf("bump cache generation of store:%d failed, err:%v", storeID, err)
+	}
+}
+
+// cacheOptions 缓存策略,见conf.Data_Cache
+type cacheOptions struct {
+	ttl         time.Duration
+	negativeTTL time.Duration
+	jitter      float64
+	staleTTL    time.Duration
+	lockTTL     time.Duration
+}
+
+func newCacheOptions(cfg *conf.Data_Cache) cacheOptions {
+	o := cacheOptions{
+		ttl:         cfg.GetTtl().AsDuration(),
+		negativeTTL: cfg.GetNegativeTtl().AsDuration(),
+		jitter:      cfg.GetJitter(),
+		staleTTL:    cfg.GetStaleTtl().AsDuration(),
+		lockTTL:     cfg.GetLockTtl().AsDuration(),
+	}
+	// 没有配置时使用默认值
+	if o.ttl <= 0 {
+		o.ttl = time.Minute
+	}
+	if o.negativeTTL <= 0 {
+		o.negativeTTL = 10 * time.Second
+	}
+	if o.jitter <= 0 {
+		o.jitter = 0.1
+	}
+	if o.lockTTL <= 0 {
+		o.lockTTL = 3 * time.Second
+	}
+	return o
+}
+
+// cacheEntry 缓存中保存的值
+// redis中的过期时间比ExpireAt多出staleTTL,这段时间内的旧值可以先返回给调用方
+type cacheEntry struct {
+	Data     json.RawMessage `json:"data"`
+	ExpireAt int64           `json:"expire_at"` // 逻辑过期时间,unix毫秒
+}
+
+// loadFunc 回源查询,返回json格式的数据;empty为true时按空结果的时间缓存
+type loadFunc func(ctx context.Context) (data []byte, empty bool, err error)
+
+// 回源锁的key
+const cacheLockKey = "lock:%s"
+
+// 只有锁的持有者才能释放锁,避免租期过后把别人的锁删掉
+var unlockScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) else return 0 end`)
+
+// cached 先查redis缓存,没有再回源查询并写入缓存
+// 1.同一个实例内的并发请求通过singleflight合并
+// 2.多个实例之间通过redis中的回源锁保证同一个key同时只有一个实例查询ES,其他实例等待缓存写入
+// 3.配置了staleTTL时,过期不久的旧值直接返回,同时在后台刷新
+// 4.Redis不可用时直接回源,不读写缓存
+func (r reviewRepo) cached(ctx context.Context, key string, load loadFunc) ([]byte, error) {
+	v, err, _ := g.Do(key, func() (any, error) {
+		entry, err := r.getCache(key)
+		if err == nil {
+			if time.Now().UnixMilli() < entry.ExpireAt {
+				return []byte(entry.Data), nil
+			}
+			if r.data.cache.staleTTL > 0 {
+				go r.revalidate(key, load)
+				return []byte(entry.Data), nil
+			}
+		} else if !errors.Is(err, redis.Nil) {
+			// 查缓存失败(Redis挂掉了),降级为直接回源,相同的请求仍然由singleflight合并
+			r.log.Warnf("read cache %s failed, load directly, err:%v", key, err)
+			data, _, err := load(ctx)
+			return data, err
+		}
+		return r.refill(ctx, key, load)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]byte), nil
+}
+
+// refill 拿到回源锁之后查询并写入缓存;没拿到锁时等待其他实例写入缓存,等待超时再自己回源
+func (r reviewRepo) refill(ctx context.Context, key string, load loadFunc) ([]byte, error) {
+	token, ok, err := r.lock(key)
+	if err != nil {
+		// 拿锁失败(Redis挂掉了),直接回源,也不再写缓存
+		r.log.Warnf("lock cache %s failed, load directly, err:%v", key, err)
+		data, _, err := load(ctx)
+		return data, err
+	}
+	if ok {
+		defer r.unlock(key, token)
+	} else if data, ok := r.waitCache(ctx, key); ok {
+		return data, nil
+	}
+	data, empty, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 写缓存失败不影响这次的结果
+	if err := r.setCache(key, data, empty); err != nil {
+		r.log.Errorf("write cache %s failed, err:%v", key, err)
+	}
+	return data, nil
+}
+
+// revalidate 后台刷新过期的缓存,其他实例正在刷新时直接返回
+func (r reviewRepo) revalidate(key string, load loadFunc) {
+	token, ok, err := r.lock(key)
+	if err != nil || !ok {
+		return
+	}
+	defer r.unlock(key, token)
+	ctx, cancel := context.WithTimeout(context.Background(), r.data.cache.lockTTL)
+	defer cancel()
+	data, empty, err := load(ctx)
+	if err != nil {
+		r.log.Errorf("revalidate cache %s failed, err:%v", key, err)
+		return
+	}
+	if err := r.setCache(key, data, empty); err != nil {
+		r.log.Errorf("write cache %s failed, err:%v", key, err)
+	}
+}
+
+// waitCache 轮询等待其他实例写入缓存,最多等待一个锁的租期
+func (r reviewRepo) waitCache(ctx context.Context, key string) ([]byte, bool) {
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.Now().Add(r.data.cache.lockTTL)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-ticker.C:
+		}
+		entry, err := r.getCache(key)
+		if err == nil && time.Now().UnixMilli() < entry.ExpireAt {
+			return entry.Data, true
+		}
+	}
+	return nil, false
+}
+
+func (r reviewRepo) getCache(key string) (*cacheEntry, error) {
+	var b []byte
+	err := r.data.redisBreaker.do(func() (err error) {
+		b, err = r.data.rdb.Get(key).Bytes()
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	entry := new(cacheEntry)
+	if err := json.Unmarshal(b, entry); err != nil {
+		// 格式不对的旧缓存当作不存在
+		return nil, redis.Nil
+	}
+	return entry, nil
+}
+
+// setCache 写入缓存,过期时间加上随机的抖动
+func (r reviewRepo) setCache(key string, data []byte, empty bool) error {
+	o := r.data.cache
+	ttl := o.ttl
+	if empty {
+		ttl = o.negativeTTL
+	}
+	ttl += time.Duration(rand.Float64() * o.jitter * float64(ttl))
+	b, err := json.Marshal(cacheEntry{
+		Data:     data,
+		ExpireAt: time.Now().Add(ttl).UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+	return r.data.redisBreaker.do(func() error {
+		return r.data.rdb.Set(key, b, ttl+o.staleTTL).Err()
+	})
+}
+
+// lock 获取回源锁,返回锁的token
+func (r reviewRepo) lock(key string) (string, bool, error) {
+	token := strconv.FormatInt(rand.Int63(), 36)
+	var ok bool
+	err := r.data.redisBreaker.do(func() (err error) {
+		ok, err = r.data.rdb.SetNX(fmt.Sprintf(cacheLockKey, key), token, r.data.cache.lockTTL).Result()
+		return err
+	})
+	return token, ok, err
+}
+
+func (r reviewRepo) unlock(key, token string) {
+	err := r.data.redisBreaker.do(func() error {
+		return unlockScript.Run(r.data.rdb, []string{fmt.Sprintf(cacheLockKey, key)}, token).Err()
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.log.Errorf("unlock cache %s failed, err:%v", key, err)
+	}
+}
@@ -16,7 +16,7 @@ import (
 )
 
 // ProviderSet is data providers.
-var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewDB, NewES, NewRdbClient)
+var ProviderSet = wire.NewSet(NewData, NewReviewRepo, NewDB, NewES, NewRdbClient, NewKafkaWriter, NewOutboxRelay, NewHealth, NewIdempotencyStore, NewObjectStore)
 
 // Data .
 type Data struct {
@@ -24,19 +24,38 @@ type Data struct {
 	query *query.Query
 	es    *elasticsearch.TypedClient
 	rdb   *redis.Client
+	cache cacheOptions
+	// 评价索引的名字(review-job维护的别名)
+	esIndex string
+
+	// Redis、ES的熔断器,打开时分别降级为直接查ES、查MySQL
+	redisBreaker *breaker
+	esBreaker    *breaker
 }
 
+// 没有配置时的评价索引
+const defaultESIndex = "review"
+
 // NewData .
-func NewData(db *gorm.DB, es *elasticsearch.TypedClient, rdb *redis.Client, logger log.Logger) (*Data, func(), error) {
+func NewData(cfg *conf.Data, esCfg *conf.ES, db *gorm.DB, es *elasticsearch.TypedClient, rdb *redis.Client, logger log.Logger) (*Data, func(), error) {
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
 	}
 	// 设置全局数据连接
 	query.SetDefault(db)
+	index := esCfg.GetIndex()
+	if index == "" {
+		index = defaultESIndex
+	}
 	return &Data{
-		query: query.Q,
-		es:    es,
-		rdb:   rdb,
+		query:   query.Q,
+		es:      es,
+		rdb:     rdb,
+		cache:   newCacheOptions(cfg.GetCache()),
+		esIndex: index,
+
+		redisBreaker: newBreaker("redis", cfg.GetBreaker(), redisHealthy),
+		esBreaker:    newBreaker("es", cfg.GetBreaker(), esHealthy),
 	}, cleanup, nil
 }
 
@@ -63,7 +82,8 @@ func NewDB(c *conf.Data) (*gorm.DB, error) {
 	dsn := c.Database.Source
 	switch strings.ToLower(driver) {
 	case "mysql":
-		db, err := gorm.Open(mysql.Open(dsn))
+		// TranslateError:违反唯一索引时返回gorm.ErrDuplicatedKey,用来识别并发的重复提交
+		db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{TranslateError: true})
 		if err != nil {
 			panic(fmt.Errorf("connect db failed,%v", err))
 		}
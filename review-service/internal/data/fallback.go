@@ -0,0 +1,123 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/data/model"
+	"strconv"
+	"time"
+
+	"gorm.io/gen"
+)
+
+// listStoreReviewsFromDB ES不可用时直接从MySQL查询商家的评价,不走缓存
+// 排序和ES保持一致(create_at倒序,相同时按review_id倒序),pageToken的格式也和ES的search_after相同,降级前后可以继续翻页
+func (r reviewRepo) listStoreReviewsFromDB(ctx context.Context, storeID int64, status *int32, page *biz.PageParam) (*biz.ReviewDocumentPage, error) {
+	q := r.data.query.ReviewInfo
+	// ES中只有未删除的评价
+	conds := []gen.Condition{q.StoreID.Eq(storeID), q.DeleteAt.IsNull()}
+	if status != nil {
+		conds = append(conds, q.Status.Eq(*status))
+	}
+	total, err := q.WithContext(ctx).Where(conds...).Count()
+	if err != nil {
+		return nil, err
+	}
+	do := q.WithContext(ctx).Where(conds...)
+	if page.PageToken != "" {
+		token, err := decodePageToken(page.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		createAt, reviewID, err := parseSearchAfter(token.After)
+		if err != nil {
+			return nil, err
+		}
+		do = do.Where(q.WithContext(ctx).Where(q.CreateAt.Lt(createAt)).Or(q.CreateAt.Eq(createAt), q.ReviewID.Lt(reviewID)))
+	} else {
+		do = do.Offset(page.Offset)
+	}
+	// 多查一条,用来判断是否还有下一页
+	list, err := do.Order(q.CreateAt.Desc(), q.ReviewID.Desc()).Limit(page.Limit + 1).Find()
+	if err != nil {
+		return nil, err
+	}
+	ret := &biz.ReviewDocumentPage{Total: total}
+	if len(list) > page.Limit {
+		list = list[:page.Limit]
+		last := list[page.Limit-1]
+		ret.NextPageToken = encodePageToken(pageToken{
+			After: []interface{}{last.CreateAt.UnixMilli(), strconv.FormatInt(last.ReviewID, 10)},
+		})
+	}
+	if ret.List, err = r.reviewDocuments(ctx, list); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// reviewDocuments 查询评价的回复、申诉和追评,拼成和ES中相同的评价文档
+func (r reviewRepo) reviewDocuments(ctx context.Context, list []*model.ReviewInfo) ([]*biz.ReviewDocument, error) {
+	docs := make([]*biz.ReviewDocument, 0, len(list))
+	if len(list) == 0 {
+		return docs, nil
+	}
+	ids := make([]int64, 0, len(list))
+	for _, review := range list {
+		ids = append(ids, review.ReviewID)
+	}
+	rq := r.data.query.ReviewReplyInfo
+	replies, err := rq.WithContext(ctx).Where(rq.ReviewID.In(ids...), rq.DeleteAt.IsNull()).Find()
+	if err != nil {
+		return nil, err
+	}
+	aq := r.data.query.ReviewAppealInfo
+	appeals, err := aq.WithContext(ctx).Where(aq.ReviewID.In(ids...), aq.DeleteAt.IsNull()).Find()
+	if err != nil {
+		return nil, err
+	}
+	replyMap := make(map[int64]*model.ReviewReplyInfo, len(replies))
+	for _, reply := range replies {
+		replyMap[reply.ReviewID] = reply
+	}
+	appealMap := make(map[int64]*model.ReviewAppealInfo, len(appeals))
+	for _, appeal := range appeals {
+		appealMap[appeal.ReviewID] = appeal
+	}
+	pq := r.data.query.ReviewAppendInfo
+	appends, err := pq.WithContext(ctx).Where(pq.ReviewID.In(ids...), pq.DeleteAt.IsNull()).Find()
+	if err != nil {
+		return nil, err
+	}
+	appendMap := make(map[int64]*model.ReviewAppendInfo, len(appends))
+	for _, appended := range appends {
+		appendMap[appended.ReviewID] = appended
+	}
+	for _, review := range list {
+		docs = append(docs, &biz.ReviewDocument{
+			ReviewInfo: review,
+			Reply:      replyMap[review.ReviewID],
+			Appeal:     appealMap[review.ReviewID],
+			Append:     appendMap[review.ReviewID],
+		})
+	}
+	return docs, nil
+}
+
+// parseSearchAfter 解析ES的sort值:create_at的毫秒时间戳和review_id
+func parseSearchAfter(after []interface{}) (time.Time, int64, error) {
+	if len(after) != 2 {
+		return time.Time{}, 0, v1.ErrorInvalidParam("无效的pageToken")
+	}
+	ms, err := strconv.ParseInt(fmt.Sprint(after[0]), 10, 64)
+	if err != nil {
+		return time.Time{}, 0, v1.ErrorInvalidParam("无效的pageToken")
+	}
+	reviewID, err := strconv.ParseInt(fmt.Sprint(after[1]), 10, 64)
+	if err != nil {
+		return time.Time{}, 0, v1.ErrorInvalidParam("无效的pageToken")
+	}
+	return time.UnixMilli(ms), reviewID, nil
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis"
+)
+
+// 幂等键在redis中的key:idem:<方法>:<调用方>:<幂等键>
+const idempotencyKey = "idem:%s:%s:%s"
+
+// 处理完成的记录,这段时间内用相同的幂等键重试会拿到原来的响应
+const idempotencyTTL = 24 * time.Hour
+
+// 只有占用幂等键的请求才能写入响应或者释放,避免处理超时之后把别人重新占用的记录覆盖或删掉
+// ARGV[1]是占用时写入的处理中的记录
+var completeIdempotencyScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then redis.call("set", KEYS[1], ARGV[2], "PX", ARGV[3]) return 1 else return 0 end`)
+
+// IdempotencyRecord 一个幂等键的记录
+type IdempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`        // 请求内容的摘要,相同的幂等键只能用于相同的请求
+	Token       string `json:"token,omitempty"`    // 处理中的记录的持有者,由占用幂等键的请求生成
+	Done        bool   `json:"done"`               // 是否处理完成
+	Response    []byte `json:"response,omitempty"` // 处理完成时的响应
+}
+
+// IdempotencyStore 在redis中保存幂等键以及对应的响应
+type IdempotencyStore struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewIdempotencyStore(data *Data, logger log.Logger) *IdempotencyStore {
+	return &IdempotencyStore{data: data, log: log.NewHelper(logger)}
+}
+
+// Begin 占用幂等键,处理中的记录在pendingTTL之后过期,过期之后允许重试
+// 占用成功时返回持有者的token,用于Complete、Release;否则返回之前使用这个幂等键的请求的记录
+func (s *IdempotencyStore) Begin(operation, caller, key, fingerprint string, pendingTTL time.Duration) (string, *IdempotencyRecord, error) {
+	k := fmt.Sprintf(idempotencyKey, operation, caller, key)
+	token := strconv.FormatInt(rand.Int63(), 36)
+	b, err := pendingRecord(fingerprint, token)
+	if err != nil {
+		return "", nil, err
+	}
+	var ok bool
+	err = s.data.redisBreaker.do(func() (err error) {
+		ok, err = s.data.rdb.SetNX(k, b, pendingTTL).Result()
+		return err
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if ok {
+		return token, nil, nil
+	}
+	err = s.data.redisBreaker.do(func() (err error) {
+		b, err = s.data.rdb.Get(k).Bytes()
+		return err
+	})
+	if errors.Is(err, redis.Nil) {
+		// 刚好过期了,重新占用
+		return s.Begin(operation, caller, key, fingerprint, pendingTTL)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	rec := new(IdempotencyRecord)
+	if err := json.Unmarshal(b, rec); err != nil {
+		return "", nil, err
+	}
+	return "", rec, nil
+}
+
+// Complete 保存处理完成的响应,幂等键已经过期或者被其他请求占用时返回错误
+func (s *IdempotencyStore) Complete(operation, caller, key, fingerprint, token string, response []byte) error {
+	pending, err := pendingRecord(fingerprint, token)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(&IdempotencyRecord{Fingerprint: fingerprint, Done: true, Response: response})
+	if err != nil {
+		return err
+	}
+	var n int
+	err = s.data.redisBreaker.do(func() (err error) {
+		k := fmt.Sprintf(idempotencyKey, operation, caller, key)
+		n, err = completeIdempotencyScript.Run(s.data.rdb, []string{k}, pending, b, idempotencyTTL.Milliseconds()).Int()
+		return err
+	})
+	if err == nil && n == 0 {
+		err = fmt.Errorf("idempotency key %s is no longer held", key)
+	}
+	return err
+}
+
+// Release 处理失败时释放幂等键,允许调用方用相同的幂等键重试
+func (s *IdempotencyStore) Release(operation, caller, key, fingerprint, token string) {
+	pending, err := pendingRecord(fingerprint, token)
+	if err == nil {
+		err = s.data.redisBreaker.do(func() error {
+			return unlockScript.Run(s.data.rdb, []string{fmt.Sprintf(idempotencyKey, operation, caller, key)}, pending).Err()
+		})
+	}
+	if err != nil {
+		s.log.Errorf("release idempotency key %s failed, err:%v", key, err)
+	}
+}
+
+// pendingRecord 处理中的记录,Complete、Release时和redis中的值比较,一致才说明幂等键还被自己占用
+func pendingRecord(fingerprint, token string) ([]byte, error) {
+	return json.Marshal(&IdempotencyRecord{Fingerprint: fingerprint, Token: token})
+}
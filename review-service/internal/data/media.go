@@ -0,0 +1,161 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"review-service/pkg/objectstore"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// NewObjectStore 根据配置创建评价图片、视频的对象存储
+func NewObjectStore(c *conf.Data, data *Data) (objectstore.Store, error) {
+	oc := c.GetObjectStore()
+	switch strings.ToLower(oc.GetDriver()) {
+	case "fs":
+		return objectstore.NewFS(oc.GetRoot(), oc.GetBaseUrl(), oc.GetSecret(), mediaCatalog{data: data})
+	default:
+		return nil, fmt.Errorf("unsupported object store driver: %q", oc.GetDriver())
+	}
+}
+
+// mediaCatalog 按媒体表提供对象的类型和是否等待上传,缩略图由服务端生成,都是jpeg
+type mediaCatalog struct {
+	data *Data
+}
+
+func (c mediaCatalog) Lookup(ctx context.Context, key string) (*objectstore.ObjectMeta, error) {
+	q := c.data.query.ReviewMediaInfo
+	media, err := q.WithContext(ctx).Where(q.WithContext(ctx).Where(q.ObjectKey.Eq(key)).Or(q.ThumbnailKey.Eq(key))).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, objectstore.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if media.ObjectKey != key {
+		return &objectstore.ObjectMeta{ContentType: "image/jpeg"}, nil
+	}
+	return &objectstore.ObjectMeta{ContentType: media.ContentType, Uploadable: media.Status == biz.MediaStatusPending}, nil
+}
+
+// SaveMedia 保存申请上传的媒体
+func (r reviewRepo) SaveMedia(ctx context.Context, media *model.ReviewMediaInfo) error {
+	return r.data.query.ReviewMediaInfo.WithContext(ctx).Create(media)
+}
+
+// GetMedia 获取媒体
+func (r reviewRepo) GetMedia(ctx context.Context, mediaID int64) (*model.ReviewMediaInfo, error) {
+	q := r.data.query.ReviewMediaInfo
+	media, err := q.WithContext(ctx).Where(q.MediaID.Eq(mediaID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, v1.ErrorMediaNotFound("媒体:%d不存在", mediaID)
+	}
+	return media, err
+}
+
+// GetMediaByKeys 按对象key批量获取媒体,不存在的key不返回
+func (r reviewRepo) GetMediaByKeys(ctx context.Context, objectKeys []string) ([]*model.ReviewMediaInfo, error) {
+	q := r.data.query.ReviewMediaInfo
+	return q.WithContext(ctx).Where(q.ObjectKey.In(objectKeys...)).Find()
+}
+
+// CompleteMedia 确认上传完成,只有待上传的媒体可以更新(CAS)
+func (r reviewRepo) CompleteMedia(ctx context.Context, media *model.ReviewMediaInfo) error {
+	q := r.data.query.ReviewMediaInfo
+	info, err := q.WithContext(ctx).
+		Where(q.MediaID.Eq(media.MediaID), q.Status.Eq(biz.MediaStatusPending)).
+		Updates(map[string]interface{}{
+			"status":        media.Status,
+			"size":          media.Size,
+			"content_type":  media.ContentType,
+			"width":         media.Width,
+			"height":        media.Height,
+			"content_hash":  media.ContentHash,
+			"thumbnail_key": media.ThumbnailKey,
+			"expire_at":     media.ExpireAt,
+			"version":       gorm.Expr("version + 1"),
+		})
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected == 0 {
+		return v1.ErrorConcurrentModification("媒体:%d已被修改,请刷新后重试", media.MediaID)
+	}
+	return nil
+}
+
+// bindMedia 在保存评价、追评、回复、申诉的事务中把媒体关联到评价
+// 只能关联已上传的媒体,或者已经关联在同一条评价上的媒体(修改评价时重新提交),有一个不满足时整个事务回滚
+func bindMedia(ctx context.Context, tx *query.Query, reviewID int64, mediaIDs []int64) error {
+	if len(mediaIDs) == 0 {
+		return nil
+	}
+	q := tx.ReviewMediaInfo
+	info, err := q.WithContext(ctx).
+		Where(q.MediaID.In(mediaIDs...)).
+		Where(q.WithContext(ctx).
+			Where(q.Status.Eq(biz.MediaStatusUploaded)).
+			Or(q.Status.Eq(biz.MediaStatusBound), q.ReviewID.Eq(reviewID))).
+		Updates(map[string]interface{}{
+			"status":    biz.MediaStatusBound,
+			"review_id": reviewID,
+			// 版本号每次都会变化,已关联在同一条评价上的记录也会计入RowsAffected
+			"version": gorm.Expr("version + 1"),
+		})
+	if err != nil {
+		return err
+	}
+	if info.RowsAffected != int64(len(mediaIDs)) {
+		return v1.ErrorMediaAlreadyUsed("媒体已关联其他评价或已被清理")
+	}
+	return nil
+}
+
+// unbindMedia 在修改评价的事务中解除不再引用的媒体与评价的关联,恢复为已上传状态,expireAt之后没有重新关联的由清理任务删除
+// 只更新仍然关联在这条评价上的媒体,已经不满足条件的忽略
+func unbindMedia(ctx context.Context, tx *query.Query, reviewID int64, mediaIDs []int64, expireAt time.Time) error {
+	if len(mediaIDs) == 0 {
+		return nil
+	}
+	q := tx.ReviewMediaInfo
+	_, err := q.WithContext(ctx).
+		Where(q.MediaID.In(mediaIDs...), q.Status.Eq(biz.MediaStatusBound), q.ReviewID.Eq(reviewID)).
+		Updates(map[string]interface{}{
+			"status":    biz.MediaStatusUploaded,
+			"review_id": 0,
+			"expire_at": expireAt,
+			"version":   gorm.Expr("version + 1"),
+		})
+	return err
+}
+
+// ListExpiredMedia 过期没有上传、或者上传之后一直没有关联评价的媒体
+func (r reviewRepo) ListExpiredMedia(ctx context.Context, before time.Time, limit int) ([]*model.ReviewMediaInfo, error) {
+	q := r.data.query.ReviewMediaInfo
+	return q.WithContext(ctx).
+		Where(q.Status.In(biz.MediaStatusPending, biz.MediaStatusUploaded), q.ExpireAt.Lt(before)).
+		Order(q.ExpireAt).
+		Limit(limit).
+		Find()
+}
+
+// DeleteMedia 删除没有关联评价的媒体,已关联评价时不删除并返回false
+func (r reviewRepo) DeleteMedia(ctx context.Context, mediaID int64) (bool, error) {
+	q := r.data.query.ReviewMediaInfo
+	info, err := q.WithContext(ctx).
+		Where(q.MediaID.Eq(mediaID), q.Status.In(biz.MediaStatusPending, biz.MediaStatusUploaded)).
+		Delete()
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
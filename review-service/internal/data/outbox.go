@@ -0,0 +1,191 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"review-service/internal/biz"
+	"review-service/internal/conf"
+	"review-service/internal/data/model"
+	"review-service/internal/data/query"
+	"review-service/pkg/snowflake"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/gorm"
+)
+
+// outbox事件的投递状态
+const (
+	outboxPending   = 10 // 待投递
+	outboxPublished = 20 // 已投递
+)
+
+// relay的分布式锁,同一时间只允许一个实例投递,保证同一条评价的事件按顺序进入kafka
+const outboxRelayLockKey = "review:outbox:relay"
+
+// saveEvent 在事务tx中写入一条领域事件
+// 需要和状态变更使用同一个tx,保证状态变更与事件要么都成功、要么都失败
+func saveEvent(ctx context.Context, tx *query.Query, eventType string, reviewID int64, data interface{}) error {
+	event := biz.ReviewEvent{
+		EventID:    snowflake.GenID(),
+		EventType:  eventType,
+		ReviewID:   reviewID,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return tx.ReviewEventOutbox.WithContext(ctx).Create(&model.ReviewEventOutbox{
+		EventID:   event.EventID,
+		EventType: eventType,
+		ReviewID:  reviewID,
+		Payload:   string(payload),
+		Status:    outboxPending,
+	})
+}
+
+func NewKafkaWriter(cfg *conf.Kafka) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(cfg.GetBrokers()...),
+		Topic:        cfg.GetTopic(),
+		Balancer:     &kafka.Hash{}, // 按key(ReviewID)分区,同一条评价的事件进入同一个分区
+		RequiredAcks: kafka.RequireAll,
+	}
+}
+
+// OutboxRelay 将outbox表中待投递的事件按写入顺序投递到kafka,实现Transport.Server接口
+// 投递成功后才标记为已投递,失败时下一轮从同一位置重新投递(至少一次)
+type OutboxRelay struct {
+	data      *Data
+	writer    *kafka.Writer
+	interval  time.Duration
+	batchSize int
+	owner     string // 分布式锁的持有者标识
+	log       *log.Helper
+}
+
+func NewOutboxRelay(data *Data, writer *kafka.Writer, cfg *conf.Kafka, logger log.Logger) *OutboxRelay {
+	interval := cfg.GetPollInterval().AsDuration()
+	if interval <= 0 {
+		interval = time.Second
+	}
+	batchSize := int(cfg.GetBatchSize())
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	hostname, _ := os.Hostname()
+	return &OutboxRelay{
+		data:      data,
+		writer:    writer,
+		interval:  interval,
+		batchSize: batchSize,
+		owner:     fmt.Sprintf("%s:%d", hostname, os.Getpid()),
+		log:       log.NewHelper(logger),
+	}
+}
+
+// Start kratos程序启动之后会调用的方法,ctx在程序退出时会被取消
+func (o *OutboxRelay) Start(ctx context.Context) error {
+	o.log.Debug("OutboxRelay start....")
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		// 一直投递直到没有积压的事件
+		// 每批投递之前都要获取(续期)锁,排空积压的时间超过锁的租期时,不会有两个实例同时投递
+		for o.lock() {
+			n, err := o.relay(ctx)
+			if err != nil {
+				o.log.Errorf("relay outbox events failed, err:%v", err)
+				break
+			}
+			if n < o.batchSize {
+				break
+			}
+		}
+	}
+}
+
+// Stop kratos结束之后会调用的
+func (o *OutboxRelay) Stop(context.Context) error {
+	o.log.Debug("OutboxRelay stop....")
+	return o.writer.Close()
+}
+
+// renewScript 锁由自己持有时才续期,判断和续期需要是原子的
+var renewScript = redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("pexpire", KEYS[1], ARGV[2]) else return 0 end`)
+
+// lock 获取(或续期)relay的分布式锁
+func (o *OutboxRelay) lock() bool {
+	ttl := 3 * o.interval
+	if ttl < 10*time.Second {
+		ttl = 10 * time.Second
+	}
+	ok, err := o.data.rdb.SetNX(outboxRelayLockKey, o.owner, ttl).Result()
+	if err != nil {
+		o.log.Errorf("acquire outbox relay lock failed, err:%v", err)
+		return false
+	}
+	if ok {
+		return true
+	}
+	// 锁已存在,如果是自己持有的则续期
+	n, err := renewScript.Run(o.data.rdb, []string{outboxRelayLockKey}, o.owner, ttl.Milliseconds()).Int()
+	if err != nil {
+		o.log.Errorf("renew outbox relay lock failed, err:%v", err)
+		return false
+	}
+	return n == 1
+}
+
+// relay 投递一批待投递的事件,返回投递的条数
+func (o *OutboxRelay) relay(ctx context.Context) (int, error) {
+	q := o.data.query.ReviewEventOutbox
+	events, err := q.WithContext(ctx).
+		Where(q.Status.Eq(outboxPending)).
+		Order(q.ID).
+		Limit(o.batchSize).
+		Find()
+	if err != nil || len(events) == 0 {
+		return 0, err
+	}
+	ids := make([]int64, 0, len(events))
+	msgs := make([]kafka.Message, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.ID)
+		msgs = append(msgs, kafka.Message{
+			Key:   []byte(strconv.FormatInt(e.ReviewID, 10)),
+			Value: []byte(e.Payload),
+			Headers: []kafka.Header{
+				{Key: "event_type", Value: []byte(e.EventType)},
+				{Key: "event_id", Value: []byte(strconv.FormatInt(e.EventID, 10))},
+			},
+		})
+	}
+	if err := o.writer.WriteMessages(ctx, msgs...); err != nil {
+		// 投递失败,记录失败次数,下一轮仍然从这批事件开始投递,不会跳过任何事件
+		if _, uerr := q.WithContext(ctx).Where(q.ID.In(ids...)).Update(q.RetryCount, gorm.Expr("retry_count + 1")); uerr != nil {
+			o.log.Errorf("update outbox retry_count failed, err:%v", uerr)
+		}
+		return 0, err
+	}
+	// 投递成功,标记为已投递(如果这里失败,事件会被重复投递,由消费者根据event_id去重)
+	if _, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Updates(map[string]interface{}{
+		"status":     outboxPublished,
+		"publish_at": time.Now(),
+	}); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
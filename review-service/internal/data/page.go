@@ -0,0 +1,58 @@
+package data
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	v1 "review-service/api/review/v1"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+)
+
+// pageToken 游标翻页的位置,序列化后base64编码作为NextPageToken返回,对调用方不透明
+type pageToken struct {
+	ID    int64         `json:"id,omitempty"`    // MySQL keyset:上一页最后一条记录的id
+	After []interface{} `json:"after,omitempty"` // ES search_after:上一页最后一条文档的sort值
+}
+
+func encodePageToken(t pageToken) string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(s string) (*pageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, v1.ErrorInvalidParam("无效的pageToken")
+	}
+	// sort值中的时间戳等大整数按原样保留,不转换为float64
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	t := new(pageToken)
+	if err := dec.Decode(t); err != nil {
+		return nil, v1.ErrorInvalidParam("无效的pageToken")
+	}
+	return t, nil
+}
+
+// afterToken 以一页最后一条文档的sort值生成下一页的pageToken
+func afterToken(sort []types.FieldValue) string {
+	token := pageToken{After: make([]interface{}, 0, len(sort))}
+	for _, v := range sort {
+		token.After = append(token.After, v)
+	}
+	return encodePageToken(token)
+}
+
+// searchAfter 解析pageToken中的sort值,作为ES的search_after
+func searchAfter(s string) ([]types.FieldValue, error) {
+	token, err := decodePageToken(s)
+	if err != nil {
+		return nil, err
+	}
+	after := make([]types.FieldValue, 0, len(token.After))
+	for _, v := range token.After {
+		after = append(after, v)
+	}
+	return after, nil
+}
@@ -16,49 +16,64 @@ import (
 )
 
 var (
-	Q                = new(Query)
-	ReviewAppealInfo *reviewAppealInfo
-	ReviewInfo       *reviewInfo
-	ReviewReplyInfo  *reviewReplyInfo
-	Todo             *todo
+	Q                 = new(Query)
+	ReviewAppealInfo  *reviewAppealInfo
+	ReviewAppendInfo  *reviewAppendInfo
+	ReviewEventOutbox *reviewEventOutbox
+	ReviewInfo        *reviewInfo
+	ReviewMediaInfo   *reviewMediaInfo
+	ReviewReplyInfo   *reviewReplyInfo
+	Todo              *todo
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	ReviewAppealInfo = &Q.ReviewAppealInfo
+	ReviewAppendInfo = &Q.ReviewAppendInfo
+	ReviewEventOutbox = &Q.ReviewEventOutbox
 	ReviewInfo = &Q.ReviewInfo
+	ReviewMediaInfo = &Q.ReviewMediaInfo
 	ReviewReplyInfo = &Q.ReviewReplyInfo
 	Todo = &Q.Todo
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: newReviewAppealInfo(db, opts...),
-		ReviewInfo:       newReviewInfo(db, opts...),
-		ReviewReplyInfo:  newReviewReplyInfo(db, opts...),
-		Todo:             newTodo(db, opts...),
+		db:                db,
+		ReviewAppealInfo:  newReviewAppealInfo(db, opts...),
+		ReviewAppendInfo:  newReviewAppendInfo(db, opts...),
+		ReviewEventOutbox: newReviewEventOutbox(db, opts...),
+		ReviewInfo:        newReviewInfo(db, opts...),
+		ReviewMediaInfo:   newReviewMediaInfo(db, opts...),
+		ReviewReplyInfo:   newReviewReplyInfo(db, opts...),
+		Todo:              newTodo(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	ReviewAppealInfo reviewAppealInfo
-	ReviewInfo       reviewInfo
-	ReviewReplyInfo  reviewReplyInfo
-	Todo             todo
+	ReviewAppealInfo  reviewAppealInfo
+	ReviewAppendInfo  reviewAppendInfo
+	ReviewEventOutbox reviewEventOutbox
+	ReviewInfo        reviewInfo
+	ReviewMediaInfo   reviewMediaInfo
+	ReviewReplyInfo   reviewReplyInfo
+	Todo              todo
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: q.ReviewAppealInfo.clone(db),
-		ReviewInfo:       q.ReviewInfo.clone(db),
-		ReviewReplyInfo:  q.ReviewReplyInfo.clone(db),
-		Todo:             q.Todo.clone(db),
+		db:                db,
+		ReviewAppealInfo:  q.ReviewAppealInfo.clone(db),
+		ReviewAppendInfo:  q.ReviewAppendInfo.clone(db),
+		ReviewEventOutbox: q.ReviewEventOutbox.clone(db),
+		ReviewInfo:        q.ReviewInfo.clone(db),
+		ReviewMediaInfo:   q.ReviewMediaInfo.clone(db),
+		ReviewReplyInfo:   q.ReviewReplyInfo.clone(db),
+		Todo:              q.Todo.clone(db),
 	}
 }
 
@@ -72,27 +87,36 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:               db,
-		ReviewAppealInfo: q.ReviewAppealInfo.replaceDB(db),
-		ReviewInfo:       q.ReviewInfo.replaceDB(db),
-		ReviewReplyInfo:  q.ReviewReplyInfo.replaceDB(db),
-		Todo:             q.Todo.replaceDB(db),
+		db:                db,
+		ReviewAppealInfo:  q.ReviewAppealInfo.replaceDB(db),
+		ReviewAppendInfo:  q.ReviewAppendInfo.replaceDB(db),
+		ReviewEventOutbox: q.ReviewEventOutbox.replaceDB(db),
+		ReviewInfo:        q.ReviewInfo.replaceDB(db),
+		ReviewMediaInfo:   q.ReviewMediaInfo.replaceDB(db),
+		ReviewReplyInfo:   q.ReviewReplyInfo.replaceDB(db),
+		Todo:              q.Todo.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	ReviewAppealInfo IReviewAppealInfoDo
-	ReviewInfo       IReviewInfoDo
-	ReviewReplyInfo  IReviewReplyInfoDo
-	Todo             ITodoDo
+	ReviewAppealInfo  IReviewAppealInfoDo
+	ReviewAppendInfo  IReviewAppendInfoDo
+	ReviewEventOutbox IReviewEventOutboxDo
+	ReviewInfo        IReviewInfoDo
+	ReviewMediaInfo   IReviewMediaInfoDo
+	ReviewReplyInfo   IReviewReplyInfoDo
+	Todo              ITodoDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		ReviewAppealInfo: q.ReviewAppealInfo.WithContext(ctx),
-		ReviewInfo:       q.ReviewInfo.WithContext(ctx),
-		ReviewReplyInfo:  q.ReviewReplyInfo.WithContext(ctx),
-		Todo:             q.Todo.WithContext(ctx),
+		ReviewAppealInfo:  q.ReviewAppealInfo.WithContext(ctx),
+		ReviewAppendInfo:  q.ReviewAppendInfo.WithContext(ctx),
+		ReviewEventOutbox: q.ReviewEventOutbox.WithContext(ctx),
+		ReviewInfo:        q.ReviewInfo.WithContext(ctx),
+		ReviewMediaInfo:   q.ReviewMediaInfo.WithContext(ctx),
+		ReviewReplyInfo:   q.ReviewReplyInfo.WithContext(ctx),
+		Todo:              q.Todo.WithContext(ctx),
 	}
 }
 
@@ -5,17 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	v1 "review-service/api/review/v1"
 	"review-service/internal/biz"
 	"review-service/internal/data/model"
 	"review-service/internal/data/query"
 	"review-service/pkg/snowflake"
 	"strconv"
 	"strings"
-	"time"
 
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/search"
 	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/go-redis/redis"
 	"golang.org/x/sync/singleflight"
 	"gorm.io/gorm"
 )
@@ -34,10 +35,26 @@ func NewReviewRepo(data *Data, logger log.Logger) biz.ReviewRepo {
 
 // SaveReview 将Review保存到数据库中 -data层
 // 需要传入一个Review对象,返回传入的review对象，以及可能的错误
-func (r reviewRepo) SaveReview(ctx context.Context, review *model.ReviewInfo) (ret *model.ReviewInfo, err error) {
-	err = r.data.query.ReviewInfo.
-		WithContext(ctx).
-		Save(review)
+func (r reviewRepo) SaveReview(ctx context.Context, review *model.ReviewInfo, mediaIDs []int64) (ret *model.ReviewInfo, err error) {
+	// 评价、关联媒体与ReviewCreated事件在同一个事务中写入
+	err = r.data.query.Transaction(func(tx *query.Query) error {
+		if err := tx.ReviewInfo.
+			WithContext(ctx).
+			Save(review); err != nil {
+			return err
+		}
+		if err := bindMedia(ctx, tx, review.ReviewID, mediaIDs); err != nil {
+			return err
+		}
+		return saveEvent(ctx, tx, biz.EventReviewCreated, review.ReviewID, review)
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		// 同一个订单的评价并发提交,biz层的检查都通过了,由order_id的唯一索引兜底
+		return nil, v1.ErrorOrderReviewed("订单号:%d已做过评价", review.OrderID)
+	}
+	if err == nil {
+		r.bumpStoreGeneration(review.StoreID)
+	}
 	return review, err
 }
 
@@ -54,15 +71,32 @@ func (r reviewRepo) GetReviewByOrderID(ctx context.Context, orderID int64) ([]*m
 // GetReview 获取Review信息,通过ReviewID获取Review信息
 // 需要传入一个ReviewID，返回Review对象，以及可能的错误
 func (r reviewRepo) GetReview(ctx context.Context, reviewID int64) (*model.ReviewInfo, error) {
-	return r.data.query.ReviewInfo.
+	review, err := r.data.query.ReviewInfo.
 		WithContext(ctx).
 		Where(r.data.query.ReviewInfo.ReviewID.Eq(reviewID)).
 		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, v1.ErrorReviewNotFound("评价:%d不存在", reviewID)
+	}
+	return review, err
+}
+
+// GetAppeal 获取申诉信息,通过AppealID获取申诉记录
+// 需要传入一个AppealID，返回ReviewAppealInfo对象，以及可能的错误
+func (r reviewRepo) GetAppeal(ctx context.Context, appealID int64) (*model.ReviewAppealInfo, error) {
+	appeal, err := r.data.query.ReviewAppealInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppealInfo.AppealID.Eq(appealID)).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, v1.ErrorAppealNotFound("申诉:%d不存在", appealID)
+	}
+	return appeal, err
 }
 
 // SaveReply 保存商家的回复信息,
 // 需要传入一个ReviewReplyInfo对象,返回传入的对象，以及可能的错误
-func (r reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo) (*model.ReviewReplyInfo, error) {
+func (r reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo, mediaIDs []int64) (*model.ReviewReplyInfo, error) {
 	// 1.数据校验，确保商家是否可以回复
 	// 1.1 数据合法性校验(商家已回复则不应该重复回复)
 	review, err := r.data.query.ReviewInfo.
@@ -74,30 +108,52 @@ func (r reviewRepo) SaveReply(ctx context.Context, reply *model.ReviewReplyInfo)
 		return nil, err
 	}
 	if review.HasReply == 1 {
-		return nil, errors.New("该评价商家已进行过回复")
+		return nil, v1.ErrorAlreadyReplied("评价:%d商家已进行过回复", reply.ReviewID)
 	}
 	// 1.2 水平越权校验(A商家只应该能回复自家客户的评论，而不能回复B商家的)
 	if review.StoreID != reply.StoreID {
 		// 当前商店不是用户评价的那家店,也就是出现水平越权
-		return nil, errors.New("水平越权")
+		return nil, v1.ErrorPermissionDenied("水平越权,ReviewID:%v这条评论不是对该商家的评论", reply.ReviewID)
 	}
 	// 2. 通过校验,更新数据库中的数据,保存这条Reply
 	// 2.1涉及事务(将Reply插入到ReviewReply表,同时将这个Reveiw的HasReply设置为1,即已回复过)
-	r.data.query.Transaction(func(tx *query.Query) error {
+	err = r.data.query.Transaction(func(tx *query.Query) error {
 		// 将Reply插入ReviewReply表
 		if err := tx.ReviewReplyInfo.
 			WithContext(ctx).
 			Save(reply); err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				// 并发回复同一条评价,由review_id的唯一索引兜底
+				return v1.ErrorAlreadyReplied("评价:%d商家已进行过回复", reply.ReviewID)
+			}
 			r.log.WithContext(ctx).Errorf("SaveReply create reply failed,err:%v", err)
 			return err
 		}
-		// 更新Review表对应ReviewID记录的HasReply字段
-		if _, err := tx.ReviewInfo.WithContext(ctx).Where(tx.ReviewInfo.ReviewID.Eq(reply.ReviewID)).Update(tx.ReviewInfo.HasReply, 1); err != nil {
+		// 更新Review表对应ReviewID记录的HasReply字段(按版本号做CAS更新)
+		info, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(tx.ReviewInfo.ReviewID.Eq(reply.ReviewID), tx.ReviewInfo.Version.Eq(review.Version)).
+			Updates(map[string]interface{}{
+				"has_reply": 1,
+				"version":   gorm.Expr("version + 1"),
+			})
+		if err != nil {
 			r.log.Errorf("SaveReply update review failed,err:%v", err)
 			return err
 		}
-		return nil
+		if info.RowsAffected == 0 {
+			return v1.ErrorConcurrentModification("评价:%d已被修改,请刷新后重试", reply.ReviewID)
+		}
+		if err := bindMedia(ctx, tx, reply.ReviewID, mediaIDs); err != nil {
+			return err
+		}
+		// 写入ReplyPosted事件
+		return saveEvent(ctx, tx, biz.EventReplyPosted, reply.ReviewID, reply)
 	})
+	if err != nil {
+		return nil, err
+	}
+	r.bumpStoreGeneration(reply.StoreID)
 	// 返回
 	return reply, nil
 }
@@ -111,19 +167,182 @@ func (r reviewRepo) GetReviewReply(ctx context.Context, reviewID int64) (*model.
 		First()
 }
 
+// UpdateReview 用户修改待审核的评价
+// 修改后评价重新进入待审核,清空之前的审核信息
+func (r reviewRepo) UpdateReview(ctx context.Context, param *biz.UpdateParam) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		// 只有仍处于待审核、并且版本号与期望一致时才更新(CAS),同时版本号+1
+		info, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(
+				tx.ReviewInfo.ReviewID.Eq(param.ReviewID),
+				tx.ReviewInfo.Version.Eq(*param.Version),
+				tx.ReviewInfo.Status.Eq(int32(v1.ReviewStatus_REVIEW_STATUS_PENDING)),
+			).
+			Updates(map[string]interface{}{
+				"score":         param.Score,
+				"service_score": param.ServiceScore,
+				"express_score": param.ExpressScore,
+				"content":       param.Content,
+				"pic_info":      param.PicInfo,
+				"video_info":    param.VideoInfo,
+				"has_media":     param.HasMedia,
+				"anonymous":     param.Anonymous,
+				"status":        int32(v1.ReviewStatus_REVIEW_STATUS_PENDING),
+				"op_user":       "",
+				"op_reason":     "",
+				"op_remarks":    "",
+				"version":       gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorConcurrentModification("评价:%d已被修改,请刷新后重试", param.ReviewID)
+		}
+		if err := unbindMedia(ctx, tx, param.ReviewID, param.UnbindIDs, param.UnbindExpire); err != nil {
+			return err
+		}
+		if err := bindMedia(ctx, tx, param.ReviewID, param.MediaIDs); err != nil {
+			return err
+		}
+		// 写入ReviewUpdated事件
+		return saveEvent(ctx, tx, biz.EventReviewUpdated, param.ReviewID, biz.ReviewUpdatedData{
+			Score:        param.Score,
+			ServiceScore: param.ServiceScore,
+			ExpressScore: param.ExpressScore,
+			Content:      param.Content,
+			PicInfo:      param.PicInfo,
+			VideoInfo:    param.VideoInfo,
+			HasMedia:     param.HasMedia,
+			Anonymous:    param.Anonymous,
+			Version:      *param.Version + 1,
+		})
+	})
+	if err == nil {
+		r.bumpStoreGeneration(param.StoreID)
+	}
+	return err
+}
+
+// SaveAppend 保存用户的追评
+func (r reviewRepo) SaveAppend(ctx context.Context, appended *model.ReviewAppendInfo, mediaIDs []int64) (*model.ReviewAppendInfo, error) {
+	// 追评、关联媒体与ReviewAppended事件在同一个事务中写入
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		if err := tx.ReviewAppendInfo.
+			WithContext(ctx).
+			Save(appended); err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				// 并发追评同一条评价,由review_id的唯一索引兜底
+				return v1.ErrorAlreadyAppended("评价:%d已追评过", appended.ReviewID)
+			}
+			return err
+		}
+		if err := bindMedia(ctx, tx, appended.ReviewID, mediaIDs); err != nil {
+			return err
+		}
+		return saveEvent(ctx, tx, biz.EventReviewAppended, appended.ReviewID, appended)
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.bumpStoreGeneration(appended.StoreID)
+	return appended, nil
+}
+
+// GetReviewAppend 获取评价的追评,没有追评时返回nil
+func (r reviewRepo) GetReviewAppend(ctx context.Context, reviewID int64) (*model.ReviewAppendInfo, error) {
+	appended, err := r.data.query.ReviewAppendInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppendInfo.ReviewID.Eq(reviewID)).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return appended, err
+}
+
+// GetAppend 获取追评信息,通过AppendID获取追评记录
+func (r reviewRepo) GetAppend(ctx context.Context, appendID int64) (*model.ReviewAppendInfo, error) {
+	appended, err := r.data.query.ReviewAppendInfo.
+		WithContext(ctx).
+		Where(r.data.query.ReviewAppendInfo.AppendID.Eq(appendID)).
+		First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, v1.ErrorAppendNotFound("追评:%d不存在", appendID)
+	}
+	return appended, err
+}
+
+// AuditAppend 运营审核追评
+func (r reviewRepo) AuditAppend(ctx context.Context, param *biz.AuditAppendParam) error {
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		// 按版本号做CAS更新
+		info, err := tx.ReviewAppendInfo.
+			WithContext(ctx).
+			Where(tx.ReviewAppendInfo.AppendID.Eq(param.AppendID), tx.ReviewAppendInfo.Version.Eq(*param.Version)).
+			Updates(map[string]interface{}{
+				"status":     param.Status,
+				"op_user":    param.OpUser,
+				"op_reason":  param.OpReason,
+				"op_remarks": param.OpRemarks,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorConcurrentModification("追评:%d已被修改,请刷新后重试", param.AppendID)
+		}
+		// 写入AppendAudited事件
+		return saveEvent(ctx, tx, biz.EventAppendAudited, param.ReviewID, biz.AppendAuditedData{
+			AppendID:  param.AppendID,
+			Status:    param.Status,
+			OpUser:    param.OpUser,
+			OpReason:  param.OpReason,
+			OpRemarks: param.OpRemarks,
+			Version:   *param.Version + 1,
+		})
+	})
+	if err == nil {
+		r.bumpStoreGeneration(param.StoreID)
+	}
+	return err
+}
+
 // AuditReview运营对用户评价进行审核
 // 需要传入一个审核参数对象AuditParam,返回可能的错误
 func (r reviewRepo) AuditReview(ctx context.Context, param *biz.AuditParam) error {
-	// 更新用户的评价
-	_, err := r.data.query.ReviewInfo.
-		WithContext(ctx).
-		Where(r.data.query.ReviewInfo.ReviewID.Eq(param.ReviewID)).
-		Updates(map[string]interface{}{
-			"status":     param.Status,
-			"op_user":    param.OpUser,
-			"op_reason":  param.OpReason,
-			"op_remarks": param.OpRemarks,
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		// 更新用户的评价,只有版本号与期望一致时才更新(CAS),同时版本号+1
+		info, err := tx.ReviewInfo.
+			WithContext(ctx).
+			Where(tx.ReviewInfo.ReviewID.Eq(param.ReviewID), tx.ReviewInfo.Version.Eq(*param.Version)).
+			Updates(map[string]interface{}{
+				"status":     param.Status,
+				"op_user":    param.OpUser,
+				"op_reason":  param.OpReason,
+				"op_remarks": param.OpRemarks,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if err != nil {
+			return err
+		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorConcurrentModification("评价:%d已被修改,请刷新后重试", param.ReviewID)
+		}
+		// 写入ReviewAudited事件
+		return saveEvent(ctx, tx, biz.EventReviewAudited, param.ReviewID, biz.ReviewAuditedData{
+			Status:    param.Status,
+			OpUser:    param.OpUser,
+			OpReason:  param.OpReason,
+			OpRemarks: param.OpRemarks,
+			Version:   *param.Version + 1,
 		})
+	})
+	if err == nil {
+		r.bumpStoreGeneration(param.StoreID)
+	}
 	return err
 }
 
@@ -132,13 +351,15 @@ func (r reviewRepo) AuditReview(ctx context.Context, param *biz.AuditParam) erro
 func (r reviewRepo) AppealReview(ctx context.Context, param *biz.AppealParam) (*model.ReviewAppealInfo, error) {
 	// 1.判断传入的ReviewID记录是否存在
 	review, err := r.data.query.ReviewInfo.WithContext(ctx).Where(query.ReviewInfo.ReviewID.Eq(param.ReviewID)).First()
-	fmt.Println(review)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, v1.ErrorReviewNotFound("评价:%d不存在", param.ReviewID)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("ReviewID:%v,这条评论不存在", param.ReviewID)
+		return nil, err
 	}
 	// 2.判断商家是否有权限对该评论申诉
 	if review.StoreID != param.StoreID {
-		return nil, fmt.Errorf("水平越权,ReviewID:%v这条评论不是对该商家的评论", param.ReviewID)
+		return nil, v1.ErrorPermissionDenied("水平越权,ReviewID:%v这条评论不是对该商家的评论", param.ReviewID)
 	}
 	// 3.判断申诉记录是否已存在,如果存在，并且该申诉已被处理(status > 10)，就返回
 	appeal, err := r.data.query.ReviewAppealInfo.WithContext(ctx).Where(
@@ -152,7 +373,7 @@ func (r reviewRepo) AppealReview(ctx context.Context, param *biz.AppealParam) (*
 	newAppeal := &model.ReviewAppealInfo{
 		ReviewID:  param.ReviewID,
 		StoreID:   param.StoreID,
-		Status:    10, //Attention:注意这里要被设置为10(未审核状态)，因为记录需要等待运营端的审核
+		Status:    int32(v1.AppealStatus_APPEAL_STATUS_PENDING), //Attention:注意这里要被设置为待审核状态，因为记录需要等待运营端的审核
 		Reason:    param.Reason,
 		Content:   param.Content,
 		PicInfo:   param.PicInfo,
@@ -160,81 +381,157 @@ func (r reviewRepo) AppealReview(ctx context.Context, param *biz.AppealParam) (*
 	}
 	// 如果申诉记录存在
 	if appeal != nil {
-		if appeal.Status > 10 {
-			// 3.1 如果申诉存在且已经审核过,则直接返回
-			return nil, fmt.Errorf("AppealID:%v这条申诉已经审核过,请勿重复提交,如果对结果不满意,请致电:110", appeal.AppealID)
+		if appeal.Status != int32(v1.AppealStatus_APPEAL_STATUS_PENDING) {
+			// 3.1 如果申诉存在且已经审核过,按状态机校验能否重新进入待审核(审核过的申诉是终态)
+			if err := biz.CheckAppealTransition(appeal.Status, newAppeal.Status); err != nil {
+				return nil, err
+			}
+		}
+		// 如果记录存在,但还未审核，则商家对申诉信息进行更新(按版本号做CAS更新)
+		if param.Version != nil && *param.Version != appeal.Version {
+			return nil, v1.ErrorConcurrentModification("申诉:%d已被修改,期望版本:%d,当前版本:%d", appeal.AppealID, *param.Version, appeal.Version)
 		}
-		// 如果记录存在,但还未审核，则商家对申诉信息进行更新
 		newAppeal.AppealID = appeal.AppealID
-		r.data.query.ReviewAppealInfo.
-			WithContext(ctx).
-			Where(r.data.query.ReviewAppealInfo.AppealID.Eq(appeal.AppealID)).
-			Updates(map[string]interface{}{
-				"status":     newAppeal.Status,
-				"content":    newAppeal.Content,
-				"reason":     newAppeal.Reason,
-				"pic_info":   newAppeal.PicInfo,
-				"video_info": newAppeal.VideoInfo,
-			})
+		newAppeal.Version = appeal.Version + 1
+		err = r.data.query.Transaction(func(tx *query.Query) error {
+			info, err := tx.ReviewAppealInfo.
+				WithContext(ctx).
+				Where(tx.ReviewAppealInfo.AppealID.Eq(appeal.AppealID), tx.ReviewAppealInfo.Version.Eq(appeal.Version)).
+				Updates(map[string]interface{}{
+					"status":     newAppeal.Status,
+					"content":    newAppeal.Content,
+					"reason":     newAppeal.Reason,
+					"pic_info":   newAppeal.PicInfo,
+					"video_info": newAppeal.VideoInfo,
+					"version":    gorm.Expr("version + 1"),
+				})
+			if err != nil {
+				return err
+			}
+			if info.RowsAffected == 0 {
+				return v1.ErrorConcurrentModification("申诉:%d已被修改,请刷新后重试", appeal.AppealID)
+			}
+			if err := bindMedia(ctx, tx, newAppeal.ReviewID, param.MediaIDs); err != nil {
+				return err
+			}
+			// 写入AppealFiled事件
+			return saveEvent(ctx, tx, biz.EventAppealFiled, newAppeal.ReviewID, newAppeal)
+		})
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		// 如果Appeal申诉记录不存在,则设置ID,并将这条申诉请求入库
+		if err := biz.CheckAppealTransition(int32(v1.AppealStatus_APPEAL_STATUS_UNSPECIFIED), newAppeal.Status); err != nil {
+			return nil, err
+		}
 		newAppeal.AppealID = snowflake.GenID()
-		err = r.data.query.ReviewAppealInfo.WithContext(ctx).Save(newAppeal)
-		r.log.Errorf("将Appeal申诉记录存入数据库时出错,Appeal:%v", newAppeal)
+		err = r.data.query.Transaction(func(tx *query.Query) error {
+			if err := tx.ReviewAppealInfo.WithContext(ctx).Save(newAppeal); err != nil {
+				if errors.Is(err, gorm.ErrDuplicatedKey) {
+					return v1.ErrorConcurrentModification("评价:%d的申诉已被并发提交,请刷新后重试", newAppeal.ReviewID)
+				}
+				return err
+			}
+			if err := bindMedia(ctx, tx, newAppeal.ReviewID, param.MediaIDs); err != nil {
+				return err
+			}
+			// 写入AppealFiled事件
+			return saveEvent(ctx, tx, biz.EventAppealFiled, newAppeal.ReviewID, newAppeal)
+		})
 		if err != nil {
+			r.log.Errorf("将Appeal申诉记录存入数据库时出错,Appeal:%v,err:%v", newAppeal, err)
 			return nil, err
 		}
-		return newAppeal, nil
 	}
-
-	return newAppeal, err
+	r.bumpStoreGeneration(param.StoreID)
+	return newAppeal, nil
 }
 
 // AppealReview operator运营对商家的申诉进行处理
 func (r reviewRepo) AuditAppeal(ctx context.Context, param *biz.AuditAppealParam) error {
-	// 1.判断请求是否合法
-	fmt.Println(param)
-	// 1.1 appeal记录是否存在
-	_, err := r.data.query.ReviewAppealInfo.WithContext(ctx).Where(r.data.query.ReviewAppealInfo.AppealID.Eq(param.AppealID)).First()
-	if err != nil {
-		return fmt.Errorf("获取数据时出现错误:%v", err)
-	}
-	// 使用事务，1.更新申诉表，2.如果审核中的结果是20（即通过），还需要把用户评价的Status设置为40(隐藏评价)
-	err = r.data.query.Transaction(func(tx *query.Query) error {
-		// 1.申诉表的更新
-		if _, err := tx.ReviewAppealInfo.WithContext(ctx).Where(tx.ReviewAppealInfo.AppealID.Eq(param.AppealID)).Updates(
-			map[string]interface{}{
+	// 请求的合法性(申诉是否存在、状态能否流转)已经在biz层校验过了
+	// 使用事务，1.更新申诉表，2.如果申诉通过，还需要把用户评价隐藏
+	err := r.data.query.Transaction(func(tx *query.Query) error {
+		// 1.申诉表的更新(按版本号做CAS更新)
+		info, err := tx.ReviewAppealInfo.
+			WithContext(ctx).
+			Where(tx.ReviewAppealInfo.AppealID.Eq(param.AppealID), tx.ReviewAppealInfo.Version.Eq(*param.Version)).
+			Updates(map[string]interface{}{
 				"status":  param.Status,
 				"op_user": param.OpUser,
+				"version": gorm.Expr("version + 1"),
 				// op_reason:param居然没设置op_reason
-			}); err != nil {
+			})
+		if err != nil {
 			return err
 		}
+		if info.RowsAffected == 0 {
+			return v1.ErrorConcurrentModification("申诉:%d已被修改,请刷新后重试", param.AppealID)
+		}
 		// 2.如果审核通过
-		if param.Status == 20 {
-			if _, err := tx.ReviewInfo.
+		approved := param.Status == int32(v1.AppealStatus_APPEAL_STATUS_APPROVED)
+		if approved {
+			info, err := tx.ReviewInfo.
 				WithContext(ctx).
-				Where(tx.ReviewInfo.ReviewID.Eq(param.ReviewID)).
-				Update(tx.ReviewInfo.Status, 40); err != nil {
+				Where(tx.ReviewInfo.ReviewID.Eq(param.ReviewID), tx.ReviewInfo.Version.Eq(param.ReviewVersion)).
+				Updates(map[string]interface{}{
+					"status":  int32(v1.ReviewStatus_REVIEW_STATUS_HIDDEN),
+					"version": gorm.Expr("version + 1"),
+				})
+			if err != nil {
 				return err
 			}
+			if info.RowsAffected == 0 {
+				return v1.ErrorConcurrentModification("评价:%d已被修改,请刷新后重试", param.ReviewID)
+			}
 		}
-		return nil
+		// 3.写入AppealResolved事件
+		return saveEvent(ctx, tx, biz.EventAppealResolved, param.ReviewID, biz.AppealResolvedData{
+			AppealID:     param.AppealID,
+			Status:       param.Status,
+			OpUser:       param.OpUser,
+			ReviewHidden: approved,
+		})
 	})
+	if err == nil {
+		r.bumpStoreGeneration(param.StoreID)
+	}
 	return err
 }
 
 // ListReviewByUserID 列举出用户的所有评价
-func (r reviewRepo) ListReviewByUserID(ctx context.Context, userID int64, offset, limit int) ([]*model.ReviewInfo, error) {
-	return r.data.query.ReviewInfo.
-		WithContext(ctx).
-		Where(r.data.query.ReviewInfo.UserID.Eq(userID)).
-		Offset(offset).
-		Limit(limit).
-		Find()
+// 按id升序,传了PageToken时从上一页最后一条的id之后开始查询(keyset),否则按Offset查询
+func (r reviewRepo) ListReviewByUserID(ctx context.Context, userID int64, page *biz.PageParam) (*biz.ReviewPage, error) {
+	q := r.data.query.ReviewInfo
+	total, err := q.WithContext(ctx).Where(q.UserID.Eq(userID)).Count()
+	if err != nil {
+		return nil, err
+	}
+	do := q.WithContext(ctx).Where(q.UserID.Eq(userID))
+	if page.PageToken != "" {
+		token, err := decodePageToken(page.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		do = do.Where(q.ID.Gt(token.ID))
+	} else {
+		do = do.Offset(page.Offset)
+	}
+	// 多查一条,用来判断是否还有下一页
+	list, err := do.Order(q.ID).Limit(page.Limit + 1).Find()
+	if err != nil {
+		return nil, err
+	}
+	ret := &biz.ReviewPage{List: list, Total: total}
+	if len(list) > page.Limit {
+		ret.List = list[:page.Limit]
+		ret.NextPageToken = encodePageToken(pageToken{ID: ret.List[page.Limit-1].ID})
+	}
+	return ret, nil
 }
 
-func (r *reviewRepo) getData1(ctx context.Context, storeID int64, offset, limit int) ([]*biz.MyReviewInfo, error) {
+func (r *reviewRepo) getData1(ctx context.Context, storeID int64, offset, limit int) ([]*biz.ReviewDocument, error) {
 	// 去ES中查询Review
 	resp, err := r.data.es.
 		Search().
@@ -257,17 +554,11 @@ func (r *reviewRepo) getData1(ctx context.Context, storeID int64, offset, limit
 		return nil, err
 	}
 	fmt.Printf("es result numbers total :%v\n", resp.Hits.Total.Value)
-	// 把从ES中获取的数据反序列化为MyReviewInfo形式
-	list := make([]*biz.MyReviewInfo, 0, resp.Hits.Total.Value)
+	// 把从ES中获取的数据反序列化为ReviewDocument形式
+	list := make([]*biz.ReviewDocument, 0, resp.Hits.Total.Value)
 	for _, hit := range resp.Hits.Hits {
-		tmp := &biz.MyReviewInfo{}
+		tmp := &biz.ReviewDocument{}
 		if err := json.Unmarshal(hit.Source_, tmp); err != nil {
-			/*
-				err:parsing time "2024-07-19 10:41:52" as "2006-01-02T15:04:05Z07:00": cannot parse " 10:41:52" as "T"
-				将ES中的数据反序列化到time.Time出错
-				ES中的时间格式：2024-07-19 10:30:11	不能解析为time.Time
-				go中的时间格式: 2006-01-02T15:04:05Z07:00
-			*/
 			r.log.Errorf("json.Unmarshal(hit.Source_,tmp),err:%v", err)
 			continue
 		}
@@ -277,20 +568,45 @@ func (r *reviewRepo) getData1(ctx context.Context, storeID int64, offset, limit
 }
 
 // ListReviewByStoreID 列举所有对商户的评价
-func (r reviewRepo) ListReviewByStoreID(ctx context.Context, storeID int64, offset, limit int) ([]*biz.MyReviewInfo, error) {
+// status不为nil时只返回该状态的评价
+func (r reviewRepo) ListReviewByStoreID(ctx context.Context, storeID int64, status *int32, page *biz.PageParam) (*biz.ReviewDocumentPage, error) {
 	// return r.getData1(ctx, storeID, offset, limit) //直接查ES
-	return r.getData2(ctx, storeID, offset, limit) //增加缓存和Single flight
+	ret, err := r.getData2(ctx, storeID, status, page) //增加缓存和Single flight
+	if errors.Is(err, errUnavailable) {
+		// ES不可用时降级为查询MySQL
+		r.log.WithContext(ctx).Warnf("list reviews of store:%d from ES failed, fallback to MySQL, err:%v", storeID, err)
+		return r.listStoreReviewsFromDB(ctx, storeID, status, page)
+	}
+	return ret, err
 }
 
+// 同一个实例内相同key的并发查询通过singleflight合并,见cached
 var g singleflight.Group
 
-// KEY的设计:review:store_id:offset:size
-func (r *reviewRepo) getData2(ctx context.Context, storeID int64, offset, limit int) ([]*biz.MyReviewInfo, error) {
+// KEY的设计:review:store_id:generation:status:offset:size[:page_token],status为0时表示不按状态过滤
+func (r *reviewRepo) getData2(ctx context.Context, storeID int64, status *int32, page *biz.PageParam) (*biz.ReviewDocumentPage, error) {
 	// 1.先查询redis缓存
 	// 2.缓存没有则查询ES
 	// 3.通过single fight合并短时间内大量的并发查询
-	key := fmt.Sprintf("review:%d:%d:%d", storeID, offset, limit)
-	b, err := r.getDataBySingleflight(ctx, key)
+	// 商家的评价有变化时代数会加一,key随之变化,不会读到旧的缓存
+	gen, err := r.storeGeneration(storeID)
+	var st int32
+	if status != nil {
+		st = *status
+	}
+	key := fmt.Sprintf("review:%d:%d:%d:%d:%d", storeID, gen, st, page.Offset, page.Limit)
+	if page.PageToken != "" {
+		// 游标翻页时忽略offset
+		key = fmt.Sprintf("review:%d:%d:%d:0:%d:%s", storeID, gen, st, page.Limit, page.PageToken)
+	}
+	var b []byte
+	if err != nil {
+		// 拿不到代数(Redis不可用)时不知道缓存是否还有效,直接查ES
+		r.log.WithContext(ctx).Warnf("get cache generation of store:%d failed, query ES directly, err:%v", storeID, err)
+		b, _, err = r.getDataFromES(ctx, key)
+	} else {
+		b, err = r.getDataBySingleflight(ctx, key)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -299,101 +615,97 @@ func (r *reviewRepo) getData2(ctx context.Context, storeID int64, offset, limit
 	if err := json.Unmarshal(b, hm); err != nil {
 		return nil, err
 	}
-	list := make([]*biz.MyReviewInfo, 0, hm.Total.Value)
-	for _, hit := range hm.Hits {
-		tmp := &biz.MyReviewInfo{}
+	ret := &biz.ReviewDocumentPage{List: make([]*biz.ReviewDocument, 0, len(hm.Hits))}
+	if hm.Total != nil {
+		ret.Total = hm.Total.Value
+	}
+	// ES多查了一条,用来判断是否还有下一页
+	hits := hm.Hits
+	if len(hits) > page.Limit {
+		hits = hits[:page.Limit]
+		ret.NextPageToken = afterToken(hits[page.Limit-1].Sort)
+	}
+	for _, hit := range hits {
+		tmp := &biz.ReviewDocument{}
 		if err := json.Unmarshal(hit.Source_, tmp); err != nil {
 			r.log.Errorf("json.Unmarshal(hit.Source_, tmp) failed,err:", err)
 			continue
 		}
-		list = append(list, tmp)
+		ret.List = append(ret.List, tmp)
 	}
-	return list, nil
+	return ret, nil
 }
 
 func (r *reviewRepo) getDataBySingleflight(ctx context.Context, key string) (data []byte, err error) {
-	v, err, sharded := g.Do(key, func() (any, error) {
-		// 1.查缓存
-		data, err := r.getDataFromCache(ctx, key)
-		r.log.Debugf("r.getDataFromCache(ctx, key) data:%v,err:%v", data, err)
-		if err == nil {
-			return data, nil
-		}
-		// 2.缓存中没有记录
-		if errors.Is(err, redis.Nil) {
-			fmt.Println("CACHE")
-			fmt.Println("CACHE")
-			fmt.Println("CACHE")
-			fmt.Println("CACHE")
-			fmt.Println("CACHE")
-			fmt.Println("CACHE")
-			fmt.Println("CACHE")
-			fmt.Println("CACHE")
-			fmt.Println("CACHE")
-			// 缓存中没有这个key,则去查询ES
-			data, err := r.getDataFromES(ctx, key)
-			if err == nil {
-				// (同时将ES数据写入Redis缓存)
-				return data, r.writeCache(ctx, key, data)
-			}
-			// 从ES获取数据时出现了错误
-			return nil, err
-		}
-		// 3.查缓存失败(RedisDone掉了)(直接返回错误，不继续向下传递错误,熔断)
-		return nil, err
+	return r.cached(ctx, key, func(ctx context.Context) ([]byte, bool, error) {
+		return r.getDataFromES(ctx, key)
 	})
-	r.log.Debugf("singleflight ret: v:%v err:%v shared:%v\n", v, err, sharded)
-	if err != nil {
-		return nil, err
-	}
-	return v.([]byte), nil
-}
-
-// 读取缓存
-func (r *reviewRepo) getDataFromCache(ctx context.Context, key string) ([]byte, error) {
-	r.log.Debugf("getDataFromCache key:%v\n", key)
-	fmt.Println(r.data.rdb)
-	return r.data.rdb.Get(key).Bytes()
-}
-
-// 将ES中的数据写入缓存
-func (r *reviewRepo) writeCache(ctx context.Context, key string, data []byte) error {
-	return r.data.rdb.Set(key, data, time.Minute).Err() //计入缓存，时延1Min
 }
 
 // 从ES中获取数据
-func (r *reviewRepo) getDataFromES(ctx context.Context, key string) ([]byte, error) {
+// 商家没有评价时结果为空,按空结果的时间缓存
+func (r *reviewRepo) getDataFromES(ctx context.Context, key string) ([]byte, bool, error) {
 	values := strings.Split(key, ":")
-	if len(values) < 4 {
-		// review:store_id:pagenum:size
-		return nil, errors.New("invalid key")
+	if len(values) < 6 {
+		// review:store_id:generation:status:offset:size[:page_token]
+		return nil, false, errors.New("invalid key")
+	}
+	storeID, statusStr, offsetStr, limitStr := values[1], values[3], values[4], values[5]
+	status, err := strconv.Atoi(statusStr)
+	if err != nil {
+		return nil, false, err
 	}
-	index, storeID, offsetStr, limitStr := values[0], values[1], values[2], values[3]
 	offset, err := strconv.Atoi(offsetStr)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	limit, err := strconv.Atoi(limitStr)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	resp, err := r.data.es.Search().
-		Index(index).
-		From(offset).
-		Size(limit).
+	filters := []types.Query{
+		{
+			Term: map[string]types.TermQuery{
+				"store_id": {Value: storeID},
+			},
+		},
+	}
+	if status != 0 {
+		filters = append(filters, types.Query{Term: map[string]types.TermQuery{"status": {Value: status}}})
+	}
+	desc := sortorder.Desc
+	req := r.data.es.Search().
+		Index(r.data.esIndex).
+		Size(limit+1). // 多查一条,用来判断是否还有下一页
+		TrackTotalHits(true).
 		Query(&types.Query{
 			Bool: &types.BoolQuery{
-				Filter: []types.Query{
-					{
-						Term: map[string]types.TermQuery{
-							"store_id": {Value: storeID},
-						},
-					},
-				},
+				Filter: filters,
 			},
-		}).Do(ctx)
+		}).
+		// 按创建时间倒序,相同时按review_id,保证search_after翻页稳定
+		Sort(
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"create_at": {Order: &desc}}},
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"review_id": {Order: &desc}}},
+		)
+	if len(values) > 6 {
+		// 游标翻页,从上一页最后一条之后开始(search_after),不受ES的max_result_window(10000条)限制
+		after, err := searchAfter(values[6])
+		if err != nil {
+			return nil, false, err
+		}
+		req = req.SearchAfter(after...)
+	} else {
+		req = req.From(offset)
+	}
+	var resp *search.Response
+	err = r.data.esBreaker.do(func() (err error) {
+		resp, err = req.Do(ctx)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	return json.Marshal(resp.Hits) //返回的是ES中search操作返回的HIT metadata(Hit元数据+hit到的数据)
+	data, err := json.Marshal(resp.Hits) //返回的是ES中search操作返回的HIT metadata(Hit元数据+hit到的数据)
+	return data, len(resp.Hits.Hits) == 0, err
 }
@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"strconv"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/search"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/sortorder"
+)
+
+// 高亮片段中包围关键词的标签
+var (
+	highlightPreTags  = []string{"<em>"}
+	highlightPostTags = []string{"</em>"}
+)
+
+// SearchReviews 在ES中搜索评价
+// 关键词对content做全文检索,其他条件作为filter不参与相关度打分
+// 传了PageToken时使用search_after翻页,不受ES的max_result_window(10000条)限制;否则按Offset翻页
+func (r reviewRepo) SearchReviews(ctx context.Context, param *biz.SearchParam) (*biz.SearchResult, error) {
+	query := &types.BoolQuery{Filter: searchFilters(param)}
+	if param.Keyword != "" {
+		query.Must = []types.Query{{
+			Match: map[string]types.MatchQuery{"content": {Query: param.Keyword}},
+		}}
+	}
+	req := r.data.es.Search().
+		Index(r.data.esIndex).
+		Size(param.Limit + 1). // 多查一条,用来判断是否还有下一页
+		TrackTotalHits(true).
+		Query(&types.Query{Bool: query}).
+		Sort(searchSort(param)...)
+	if param.PageToken != "" {
+		after, err := searchAfter(param.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		req = req.SearchAfter(after...)
+	} else {
+		req = req.From(param.Offset)
+	}
+	if param.Keyword != "" {
+		req = req.Highlight(&types.Highlight{
+			Fields:   map[string]types.HighlightField{"content": {}},
+			PreTags:  highlightPreTags,
+			PostTags: highlightPostTags,
+		})
+	}
+	var resp *search.Response
+	err := r.data.esBreaker.do(func() (err error) {
+		resp, err = req.Do(ctx)
+		return err
+	})
+	if err != nil {
+		r.log.Errorf("search reviews failed, err:%v", err)
+		return nil, err
+	}
+	result := &biz.SearchResult{List: make([]*biz.SearchHit, 0, param.Limit)}
+	if resp.Hits.Total != nil {
+		result.Total = resp.Hits.Total.Value
+	}
+	hits := resp.Hits.Hits
+	if len(hits) > param.Limit {
+		hits = hits[:param.Limit]
+		result.NextPageToken = afterToken(hits[param.Limit-1].Sort)
+	}
+	for _, hit := range hits {
+		doc := &biz.ReviewDocument{}
+		if err := json.Unmarshal(hit.Source_, doc); err != nil {
+			r.log.Errorf("json.Unmarshal(hit.Source_, doc) failed, err:%v", err)
+			continue
+		}
+		result.List = append(result.List, &biz.SearchHit{
+			ReviewDocument: doc,
+			Highlights:     hit.Highlight["content"],
+		})
+	}
+	return result, nil
+}
+
+// searchFilters 把搜索条件转换为ES的filter
+func searchFilters(param *biz.SearchParam) []types.Query {
+	var filters []types.Query
+	term := func(field string, value interface{}) {
+		filters = append(filters, types.Query{Term: map[string]types.TermQuery{field: {Value: value}}})
+	}
+	// id在ES中是keyword
+	if param.StoreID != nil {
+		term("store_id", strconv.FormatInt(*param.StoreID, 10))
+	}
+	if param.SpuID != nil {
+		term("spu_id", strconv.FormatInt(*param.SpuID, 10))
+	}
+	if param.SkuID != nil {
+		term("sku_id", strconv.FormatInt(*param.SkuID, 10))
+	}
+	if param.HasMedia != nil {
+		term("has_media", boolToInt(*param.HasMedia))
+	}
+	if param.HasReply != nil {
+		term("has_reply", boolToInt(*param.HasReply))
+	}
+	if param.Anonymous != nil {
+		term("anonymous", boolToInt(*param.Anonymous))
+	}
+	if param.Status != nil {
+		term("status", *param.Status)
+	}
+	if param.MinScore != nil || param.MaxScore != nil {
+		score := types.NumberRangeQuery{}
+		if param.MinScore != nil {
+			v := types.Float64(*param.MinScore)
+			score.Gte = &v
+		}
+		if param.MaxScore != nil {
+			v := types.Float64(*param.MaxScore)
+			score.Lte = &v
+		}
+		filters = append(filters, types.Query{Range: map[string]types.RangeQuery{"score": score}})
+	}
+	if param.StartTime != nil || param.EndTime != nil {
+		createAt := types.DateRangeQuery{}
+		if param.StartTime != nil {
+			v := param.StartTime.Format(time.RFC3339)
+			createAt.Gte = &v
+		}
+		if param.EndTime != nil {
+			v := param.EndTime.Format(time.RFC3339)
+			createAt.Lt = &v
+		}
+		filters = append(filters, types.Query{Range: map[string]types.RangeQuery{"create_at": createAt}})
+	}
+	return filters
+}
+
+// searchSort 排序方式,相同时按创建时间倒序、再按review_id保证分页稳定
+func searchSort(param *biz.SearchParam) []types.SortCombinations {
+	desc := sortorder.Desc
+	byTime := types.SortOptions{SortOptions: map[string]types.FieldSort{"create_at": {Order: &desc}}}
+	byID := types.SortOptions{SortOptions: map[string]types.FieldSort{"review_id": {Order: &desc}}}
+	switch {
+	case param.Sort == v1.SearchSort_SEARCH_SORT_SCORE:
+		return []types.SortCombinations{
+			types.SortOptions{SortOptions: map[string]types.FieldSort{"score": {Order: &desc}}},
+			byTime, byID,
+		}
+	case param.Sort == v1.SearchSort_SEARCH_SORT_RELEVANCE && param.Keyword != "":
+		return []types.SortCombinations{types.SortOptions{Score_: &types.ScoreSort{Order: &desc}}, byTime, byID}
+	default:
+		return []types.SortCombinations{byTime, byID}
+	}
+}
+
+func boolToInt(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
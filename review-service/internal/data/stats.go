@@ -0,0 +1,133 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/biz"
+	"strconv"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/core/search"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+)
+
+// GetStoreReviewStats 商家的评价统计
+// 商家维度的key带上缓存代数,商家的评价有变化时立即失效
+func (r reviewRepo) GetStoreReviewStats(ctx context.Context, storeID int64) (*biz.ReviewStats, error) {
+	gen, err := r.storeGeneration(storeID)
+	if err != nil {
+		// 拿不到代数(Redis不可用)时不知道缓存是否还有效,直接查ES
+		r.log.WithContext(ctx).Warnf("get cache generation of store:%d failed, query ES directly, err:%v", storeID, err)
+		return r.statsFromES(ctx, "store_id", storeID)
+	}
+	return r.reviewStats(ctx, fmt.Sprintf("store:%d", gen), "store_id", storeID)
+}
+
+// GetSpuReviewStats 商品的评价统计
+// 商品维度的key同样带上缓存代数,商品的评价写入ES后失效
+func (r reviewRepo) GetSpuReviewStats(ctx context.Context, spuID int64) (*biz.ReviewStats, error) {
+	gen, err := r.spuGeneration(spuID)
+	if err != nil {
+		r.log.WithContext(ctx).Warnf("get cache generation of spu:%d failed, query ES directly, err:%v", spuID, err)
+		return r.statsFromES(ctx, "spu_id", spuID)
+	}
+	return r.reviewStats(ctx, fmt.Sprintf("spu:%d", gen), "spu_id", spuID)
+}
+
+// reviewStats 先查redis缓存,没有再通过ES聚合计算
+// KEY的设计:review:stats:<维度>:<代数>:<id>,例如review:stats:store:<代数>:<id>
+func (r reviewRepo) reviewStats(ctx context.Context, dim, field string, id int64) (*biz.ReviewStats, error) {
+	key := fmt.Sprintf("review:stats:%s:%d", dim, id)
+	data, err := r.cached(ctx, key, func(ctx context.Context) ([]byte, bool, error) {
+		stats, err := r.statsFromES(ctx, field, id)
+		if err != nil {
+			return nil, false, err
+		}
+		data, err := json.Marshal(stats)
+		return data, stats.Count == 0, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	stats := new(biz.ReviewStats)
+	if err := json.Unmarshal(data, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// statsFromES 通过ES聚合计算评价统计
+func (r reviewRepo) statsFromES(ctx context.Context, field string, id int64) (*biz.ReviewStats, error) {
+	score, serviceScore, expressScore := "score", "service_score", "express_score"
+	hasMedia, hasReply := "has_media", "has_reply"
+	size := 5
+	good := types.Float64(biz.GoodScore)
+	req := r.data.es.Search().
+		Index(r.data.esIndex).
+		Size(0).
+		TrackTotalHits(true).
+		Query(&types.Query{
+			Bool: &types.BoolQuery{
+				Filter: []types.Query{
+					{Term: map[string]types.TermQuery{field: {Value: strconv.FormatInt(id, 10)}}},
+					{Term: map[string]types.TermQuery{"status": {Value: int32(v1.ReviewStatus_REVIEW_STATUS_APPROVED)}}},
+				},
+			},
+		}).
+		Aggregations(map[string]types.Aggregations{
+			"avg_score":         {Avg: &types.AverageAggregation{Field: &score}},
+			"avg_service_score": {Avg: &types.AverageAggregation{Field: &serviceScore}},
+			"avg_express_score": {Avg: &types.AverageAggregation{Field: &expressScore}},
+			"score_histogram":   {Terms: &types.TermsAggregation{Field: &score, Size: &size}},
+			"media":             {Sum: &types.SumAggregation{Field: &hasMedia}},
+			"reply":             {Sum: &types.SumAggregation{Field: &hasReply}},
+			"good":              {Filter: &types.Query{Range: map[string]types.RangeQuery{score: types.NumberRangeQuery{Gte: &good}}}},
+		})
+	var resp *search.Response
+	err := r.data.esBreaker.do(func() (err error) {
+		resp, err = req.Do(ctx)
+		return err
+	})
+	if err != nil {
+		r.log.Errorf("aggregate review stats failed, err:%v", err)
+		return nil, err
+	}
+	stats := &biz.ReviewStats{}
+	if resp.Hits.Total != nil {
+		stats.Count = resp.Hits.Total.Value
+	}
+	if stats.Count == 0 {
+		return stats, nil
+	}
+	aggs := resp.Aggregations
+	count := float64(stats.Count)
+	if a, ok := aggs["avg_score"].(*types.AvgAggregate); ok {
+		stats.AvgScore = float64(a.Value)
+	}
+	if a, ok := aggs["avg_service_score"].(*types.AvgAggregate); ok {
+		stats.AvgServiceScore = float64(a.Value)
+	}
+	if a, ok := aggs["avg_express_score"].(*types.AvgAggregate); ok {
+		stats.AvgExpressScore = float64(a.Value)
+	}
+	if a, ok := aggs["score_histogram"].(*types.LongTermsAggregate); ok {
+		if buckets, ok := a.Buckets.([]types.LongTermsBucket); ok {
+			for _, b := range buckets {
+				if b.Key >= 1 && b.Key <= 5 {
+					stats.ScoreHistogram[b.Key-1] = b.DocCount
+				}
+			}
+		}
+	}
+	if a, ok := aggs["media"].(*types.SumAggregate); ok {
+		stats.MediaRatio = float64(a.Value) / count
+	}
+	if a, ok := aggs["reply"].(*types.SumAggregate); ok {
+		stats.ReplyRatio = float64(a.Value) / count
+	}
+	if a, ok := aggs["good"].(*types.FilterAggregate); ok {
+		stats.GoodRate = float64(a.DocCount) / count
+	}
+	return stats, nil
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/conf"
+	"review-service/pkg/auth"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// NewAuthMiddleware JWT认证中间件,gRPC和HTTP共用
+// 校验Authorization: Bearer <token>,把token中的调用方身份放入ctx
+func NewAuthMiddleware(c *conf.Auth) (middleware.Middleware, error) {
+	method := jwtv5.GetSigningMethod(c.GetAlgorithm())
+	if method == nil {
+		return nil, fmt.Errorf("unsupported jwt algorithm:%q", c.GetAlgorithm())
+	}
+	var key interface{}
+	switch {
+	case strings.HasPrefix(method.Alg(), "HS"):
+		if c.GetSecret() == "" {
+			return nil, errors.New("jwt secret is required for " + method.Alg())
+		}
+		key = []byte(c.GetSecret())
+	case strings.HasPrefix(method.Alg(), "RS"):
+		pub, err := jwtv5.ParseRSAPublicKeyFromPEM([]byte(c.GetPublicKey()))
+		if err != nil {
+			return nil, fmt.Errorf("parse jwt public key failed, err:%w", err)
+		}
+		key = pub
+	default:
+		return nil, fmt.Errorf("unsupported jwt algorithm:%q", c.GetAlgorithm())
+	}
+	return middleware.Chain(
+		jwt.Server(
+			func(*jwtv5.Token) (interface{}, error) { return key, nil },
+			jwt.WithSigningMethod(method),
+			jwt.WithClaims(func() jwtv5.Claims { return &auth.Claims{} }),
+		),
+		identity(),
+	), nil
+}
+
+// identity 把校验过的token转换为调用方身份
+func identity() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := jwt.FromContext(ctx)
+			if !ok {
+				return nil, v1.ErrorNeedLogin("缺少身份信息")
+			}
+			c, ok := claims.(*auth.Claims)
+			if !ok || !c.Role.Valid() {
+				return nil, v1.ErrorNeedLogin("token中的角色无效")
+			}
+			if c.Role == auth.RoleConsumer && c.UserID <= 0 {
+				return nil, v1.ErrorNeedLogin("token中缺少用户ID")
+			}
+			if c.Role == auth.RoleMerchant && c.StoreID <= 0 {
+				return nil, v1.ErrorNeedLogin("token中缺少商家ID")
+			}
+			ctx = auth.NewContext(ctx, &auth.Identity{
+				Subject: c.Subject,
+				Role:    c.Role,
+				UserID:  c.UserID,
+				StoreID: c.StoreID,
+			})
+			return handler(ctx, req)
+		}
+	}
+}
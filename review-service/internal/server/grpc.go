@@ -3,20 +3,25 @@ package server
 import (
 	v1 "review-service/api/review/v1"
 	"review-service/internal/conf"
+	"review-service/internal/data"
 	"review-service/internal/service"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Server, review *service.ReviewService, logger log.Logger) *grpc.Server {
+func NewGRPCServer(c *conf.Server, review *service.ReviewService, authn middleware.Middleware, idem *data.IdempotencyStore, logger log.Logger) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			authn,
+			Authorization(),
 			validate.Validator(),
+			Idempotency(idem, c.Grpc.GetTimeout().AsDuration(), logger),
 		),
 	}
 	if c.Grpc.Network != "" {
@@ -1,22 +1,29 @@
 package server
 
 import (
+	stdhttp "net/http"
 	v1 "review-service/api/review/v1"
 	"review-service/internal/conf"
+	"review-service/internal/data"
 	"review-service/internal/service"
+	"review-service/pkg/objectstore"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/middleware/validate"
 	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(c *conf.Server, review *service.ReviewService, logger log.Logger) *http.Server {
+func NewHTTPServer(c *conf.Server, review *service.ReviewService, authn middleware.Middleware, idem *data.IdempotencyStore, health *data.Health, store objectstore.Store, logger log.Logger) *http.Server {
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			authn,
+			Authorization(),
 			validate.Validator(),
+			Idempotency(idem, c.Http.GetTimeout().AsDuration(), logger),
 		),
 	}
 	if c.Http.Network != "" {
@@ -30,5 +37,10 @@ func NewHTTPServer(c *conf.Server, review *service.ReviewService, logger log.Log
 	}
 	srv := http.NewServer(opts...)
 	v1.RegisterReviewHTTPServer(srv, review)
+	srv.Handle("/health", health)
+	// 本地目录的对象存储由本服务提供上传、下载,不经过认证中间件,上传按预签名校验
+	if h, ok := store.(stdhttp.Handler); ok {
+		srv.HandlePrefix(objectstore.PathPrefix, h)
+	}
 	return srv
 }
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	v1 "review-service/api/review/v1"
+	"review-service/internal/data"
+	"review-service/pkg/auth"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+)
+
+// 幂等键的请求头,gRPC通过同名的metadata传递
+const idempotencyHeader = "Idempotency-Key"
+
+// idempotentReplies 支持幂等键的方法,以及重放时用来反序列化保存的响应的类型
+var idempotentReplies = map[string]func() proto.Message{
+	v1.OperationReviewCreateReview: func() proto.Message { return new(v1.CreateReviewReply) },
+	v1.OperationReviewReplyReview:  func() proto.Message { return new(v1.ReplyReviewReply) },
+	v1.OperationReviewAppealReview: func() proto.Message { return new(v1.AppealReviewReply) },
+	v1.OperationReviewAppendReview: func() proto.Message { return new(v1.AppendReviewReply) },
+}
+
+// 没有配置服务端超时时,处理中的幂等键的有效期
+const defaultIdempotencyPendingTTL = 30 * time.Second
+
+// Idempotency 调用方带上幂等键时,相同的请求只处理一次,重试直接返回第一次处理的响应
+// 幂等键按调用方隔离;Redis不可用时不做幂等处理,重复提交由数据库的唯一索引兜底
+// 处理中的幂等键在服务端超时的两倍之后过期,超时的请求不会一直占着幂等键,也不会在处理完成之前就被重试
+func Idempotency(store *data.IdempotencyStore, timeout time.Duration, logger log.Logger) middleware.Middleware {
+	l := log.NewHelper(logger)
+	pendingTTL := 2 * timeout
+	if pendingTTL <= 0 {
+		pendingTTL = defaultIdempotencyPendingTTL
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			newReply, ok := idempotentReplies[tr.Operation()]
+			key := tr.RequestHeader().Get(idempotencyHeader)
+			id, _ := auth.FromContext(ctx)
+			msg, _ := req.(proto.Message)
+			if !ok || key == "" || id == nil || msg == nil {
+				return handler(ctx, req)
+			}
+			b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+			if err != nil {
+				return nil, err
+			}
+			sum := sha256.Sum256(b)
+			fingerprint := hex.EncodeToString(sum[:])
+			caller := fmt.Sprintf("%s-%s-%d-%d", id.Role, id.Subject, id.UserID, id.StoreID)
+
+			token, rec, err := store.Begin(tr.Operation(), caller, key, fingerprint, pendingTTL)
+			if err != nil {
+				l.Warnf("begin idempotency key %s failed, handle without idempotency, err:%v", key, err)
+				return handler(ctx, req)
+			}
+			if rec != nil {
+				if rec.Fingerprint != fingerprint {
+					return nil, v1.ErrorInvalidParam("幂等键%s已用于内容不同的请求", key)
+				}
+				if !rec.Done {
+					return nil, v1.ErrorConcurrentModification("幂等键%s的请求正在处理中,请稍后重试", key)
+				}
+				reply := newReply()
+				if err := proto.Unmarshal(rec.Response, reply); err != nil {
+					return nil, err
+				}
+				return reply, nil
+			}
+
+			reply, err := handler(ctx, req)
+			if err != nil {
+				store.Release(tr.Operation(), caller, key, fingerprint, token)
+				return nil, err
+			}
+			if m, ok := reply.(proto.Message); ok {
+				b, err := proto.Marshal(m)
+				if err == nil {
+					err = store.Complete(tr.Operation(), caller, key, fingerprint, token, b)
+				}
+				if err != nil {
+					l.Errorf("complete idempotency key %s failed, err:%v", key, err)
+				}
+			}
+			return reply, nil
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	v1 "review-service/api/review/v1"
+	"review-service/pkg/auth"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// policy 一个方法的访问策略
+type policy struct {
+	roles []auth.Role // 允许调用的角色
+	// owner 可选,在角色之外进一步校验调用方能否访问请求中的资源
+	// 需要查询数据才能判断的(例如商家只能回复自家的评价)在biz/data层校验
+	owner func(id *auth.Identity, req interface{}) bool
+}
+
+var (
+	anyone    = []auth.Role{auth.RoleConsumer, auth.RoleMerchant, auth.RoleOperator}
+	consumers = []auth.Role{auth.RoleConsumer}
+	merchants = []auth.Role{auth.RoleMerchant}
+	operators = []auth.Role{auth.RoleOperator}
+)
+
+// ListReviewByStoreID只有gRPC接口,review_http.pb.go中没有生成对应的常量
+const operationReviewListReviewByStoreID = "/api.review.v1.Review/ListReviewByStoreID"
+
+// policies C端、B端、O端的方法都在同一个Review服务中,按方法声明允许调用的角色
+// 没有声明的方法一律拒绝,新增方法时需要在这里加上策略
+var policies = map[string]policy{
+	v1.OperationReviewCreateReview: {roles: consumers},
+	// 非审核通过的评价只有评价人、所属商家和运营能看到,在biz层处理
+	v1.OperationReviewGetReview:    {roles: anyone},
+	v1.OperationReviewAuditReview:  {roles: operators},
+	v1.OperationReviewReplyReview:  {roles: merchants},
+	v1.OperationReviewAppealReview: {roles: merchants},
+	v1.OperationReviewAuditAppeal:  {roles: operators},
+	// 只能修改、追评自己的评价,在biz层校验
+	v1.OperationReviewUpdateReview:      {roles: consumers},
+	v1.OperationReviewAppendReview:      {roles: consumers},
+	v1.OperationReviewAuditAppendReview: {roles: operators},
+	// 用户只能查看自己的评价列表
+	v1.OperationReviewListReviewByUserID: {
+		roles: []auth.Role{auth.RoleConsumer, auth.RoleOperator},
+		owner: func(id *auth.Identity, req interface{}) bool {
+			r, ok := req.(*v1.ListReviewByUserIDRequest)
+			return id.Role == auth.RoleOperator || (ok && r.GetUserID() == id.UserID)
+		},
+	},
+	// 未审核通过的评价只有运营和所属商家能查到,在biz层过滤
+	operationReviewListReviewByStoreID:    {roles: anyone},
+	v1.OperationReviewGetStoreReviewStats: {roles: anyone},
+	v1.OperationReviewGetSpuReviewStats:   {roles: anyone},
+	v1.OperationReviewSearchReviews:       {roles: anyone},
+	// 用户上传评价、追评的媒体,商家上传回复、申诉的媒体;只能确认自己申请的上传,在biz层校验
+	v1.OperationReviewCreateMediaUpload:   {roles: []auth.Role{auth.RoleConsumer, auth.RoleMerchant}},
+	v1.OperationReviewCompleteMediaUpload: {roles: []auth.Role{auth.RoleConsumer, auth.RoleMerchant}},
+}
+
+// Authorization 按方法的访问策略校验调用方的角色,放在认证中间件之后
+func Authorization() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, v1.ErrorPermissionDenied("未知的调用方法")
+			}
+			id, ok := auth.FromContext(ctx)
+			if !ok {
+				return nil, v1.ErrorNeedLogin("请先登录")
+			}
+			p, ok := policies[tr.Operation()]
+			if !ok || !p.allow(id, req) {
+				return nil, v1.ErrorPermissionDenied("角色%s无权调用%s", id.Role, tr.Operation())
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+func (p policy) allow(id *auth.Identity, req interface{}) bool {
+	for _, role := range p.roles {
+		if role == id.Role {
+			return p.owner == nil || p.owner(id, req)
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	pb "review-service/api/review/v1"
 	"review-service/internal/biz"
@@ -39,7 +40,6 @@ func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 	}
 	retReview, err := s.uc.CreateReview(ctx, &model.ReviewInfo{ //调用biz层的方法
 		OrderID:      req.OrderID,      //订单ID
-		UserID:       req.UserID,       //用户ID
 		StoreID:      req.StoreID,      //商家ID
 		Score:        req.Score,        //评价评分
 		ExpressScore: req.ExpressScore, //物流评分
@@ -47,8 +47,8 @@ func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 		PicInfo:      req.PicInfo,      //照片信息
 		VideoInfo:    req.VideoInfo,    //视频信息
 		Anonymous:    annoymous,        //是否匿名?
-		Status:       0,                //评价的状态
-	})
+		Status:       int32(pb.ReviewStatus_REVIEW_STATUS_PENDING),
+	}, fromMedia(req.Media))
 	// Addition:从商户端获取其他信息
 	if err != nil {
 		log.Error("create review failed,err:", err)
@@ -62,19 +62,88 @@ func (s *ReviewService) CreateReview(ctx context.Context, req *pb.CreateReviewRe
 func (s *ReviewService) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewReply, error) {
 	// DTO->PO
 	fmt.Printf("[service]GetReview req:%v", req)
+	// 展示的内容按需打码,运营可以要求返回原文
+	mask, err := s.uc.DisplayMask(ctx, req.GetRaw())
+	if err != nil {
+		return nil, err
+	}
 	review, err := s.uc.GetReview(ctx, req.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	// 匿名评价按调用方的身份隐藏用户ID
+	user := s.uc.DisplayUser(ctx)(review.ReviewInfo)
 	return &pb.GetReviewReply{Data: &pb.ReviewInfo{
 		ReviewID:     review.ReviewID,
-		UserID:       review.UserID,
-		OrderID:      review.OrderID,
+		UserID:       user.UserID,
+		Anonymous:    user.Anonymous,
+		Pseudonym:    user.Pseudonym,
+		OrderID:      user.OrderID,
 		Score:        review.Score,
 		ServiceScore: review.ServiceScore,
 		ExpressScore: review.ExpressScore,
-		Content:      review.Content,
+		Content:      mask(review.Content),
 		PicInfo:      review.PicInfo,
 		VideoInfo:    review.VideoInfo,
-		Status:       review.Status,
-	}}, err
+		Media:        toMedia(s.uc.DisplayMedia(review.PicInfo, review.VideoInfo)),
+		Status:       pb.ReviewStatus(review.Status),
+		Version:      review.Version,
+		Append:       s.toReviewAppend(review.Append, mask),
+	}}, nil
+}
+
+// UpdateReview 用户修改待审核的评价,修改后重新审核
+func (s *ReviewService) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.UpdateReviewReply, error) {
+	var anonymous int32
+	if req.Anonymous {
+		anonymous = 1
+	}
+	version, err := s.uc.UpdateReview(ctx, &biz.UpdateParam{
+		ReviewID:     req.ReviewID,
+		Score:        req.Score,
+		ServiceScore: req.ServiceScore,
+		ExpressScore: req.ExpressScore,
+		Content:      req.Content,
+		PicInfo:      req.PicInfo,
+		VideoInfo:    req.VideoInfo,
+		Media:        fromMedia(req.Media),
+		Anonymous:    anonymous,
+		Version:      req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateReviewReply{ReviewID: req.ReviewID, Version: version}, nil
+}
+
+// AppendReview 用户追评
+func (s *ReviewService) AppendReview(ctx context.Context, req *pb.AppendReviewRequest) (*pb.AppendReviewReply, error) {
+	appended, err := s.uc.AppendReview(ctx, &model.ReviewAppendInfo{
+		ReviewID:  req.ReviewID,
+		Content:   req.Content,
+		PicInfo:   req.PicInfo,
+		VideoInfo: req.VideoInfo,
+		Status:    int32(pb.ReviewStatus_REVIEW_STATUS_PENDING),
+	}, fromMedia(req.Media))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AppendReviewReply{AppendID: appended.AppendID}, nil
+}
+
+// AuditAppendReview 运营审核追评
+func (s *ReviewService) AuditAppendReview(ctx context.Context, req *pb.AuditAppendReviewRequest) (*pb.AuditAppendReviewReply, error) {
+	err := s.uc.AuditAppend(ctx, &biz.AuditAppendParam{
+		AppendID:  req.AppendID,
+		OpReason:  req.OpReason,
+		OpRemarks: req.GetOpRemarks(),
+		Status:    int32(req.Status),
+		Version:   req.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuditAppendReviewReply{AppendID: req.AppendID, Status: req.Status}, nil
 }
 
 // AuditReview 审核用户评论,传入参数为ReviewID、以及运营人员Operator的信息
@@ -83,10 +152,10 @@ func (s *ReviewService) AuditReview(ctx context.Context, req *pb.AuditReviewRequ
 	// DTO->PO
 	err := s.uc.AuditReview(ctx, &biz.AuditParam{
 		ReviewID:  req.ReviewID,
-		OpUser:    req.OpUser,
 		OpReason:  req.OpReason,
-		OpRemarks: *req.OpRemarks,
-		Status:    req.Status,
+		OpRemarks: req.GetOpRemarks(),
+		Status:    int32(req.Status),
+		Version:   req.Version,
 	})
 	if err != nil {
 		return nil, err
@@ -99,10 +168,10 @@ func (s *ReviewService) ReplyReview(ctx context.Context, req *pb.ReplyReviewRequ
 	fmt.Printf("[service] ReplyReview req:%v", req)
 	reply, err := s.uc.CreateReply(ctx, &biz.ReplyParam{
 		ReviewID:  req.ReviewID,
-		StoreID:   req.StoreID,
 		Content:   req.Content,
 		PicInfo:   req.PicInfo,
 		VideoInfo: req.VideoInfo,
+		Media:     fromMedia(req.Media),
 	})
 	if err != nil {
 		return nil, err
@@ -115,11 +184,12 @@ func (s *ReviewService) AppealReview(ctx context.Context, req *pb.AppealReviewRe
 	fmt.Printf("[service] AppealReview req:%v\n", req)
 	appeal, err := s.uc.AppealReview(ctx, &biz.AppealParam{
 		ReviewID:  req.GetReviewID(),
-		StoreID:   req.GetStoreID(),
 		Reason:    req.GetReason(),
 		Content:   req.GetContent(),
 		PicInfo:   req.GetPicInfo(),
 		VideoInfo: req.GetVideoInfo(),
+		Media:     fromMedia(req.GetMedia()),
+		Version:   req.Version,
 	})
 	if err != nil {
 		return nil, err
@@ -127,6 +197,7 @@ func (s *ReviewService) AppealReview(ctx context.Context, req *pb.AppealReviewRe
 	fmt.Printf("[service] AppealReview ret:%v err:%v\n", appeal, err)
 	return &pb.AppealReviewReply{
 		AppealID: appeal.AppealID,
+		Version:  appeal.Version,
 	}, nil
 }
 
@@ -137,8 +208,8 @@ func (s *ReviewService) AuditAppeal(ctx context.Context, req *pb.AuditAppealRequ
 	err := s.uc.AuditAppeal(ctx, &biz.AuditAppealParam{
 		ReviewID: req.ReviewID,
 		AppealID: req.AppealID,
-		OpUser:   req.OpUser,
-		Status:   req.Status,
+		Status:   int32(req.Status),
+		Version:  req.Version,
 	})
 	// 这里只需要返回错误
 	if err != nil {
@@ -150,50 +221,256 @@ func (s *ReviewService) AuditAppeal(ctx context.Context, req *pb.AuditAppealRequ
 // ListReviewByUserID 获取用户的所有评价信息,传入参数为UserID、Page页码、Size每页的内容条数
 func (s *ReviewService) ListReviewByUserID(ctx context.Context, req *pb.ListReviewByUserIDRequest) (*pb.ListReviewByUserIDReply, error) {
 	fmt.Printf("[service] ListReviewByUserID req:%v\n", req)
-	reviews, err := s.uc.ListReviewByUserID(ctx, req.GetUserID(), int(req.GetPage()), int(req.GetSize()))
+	// 展示的内容按需打码,运营可以要求返回原文
+	mask, err := s.uc.DisplayMask(ctx, req.GetRaw())
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := s.uc.ListReviewByUserID(ctx, req.GetUserID(), int(req.GetPage()), int(req.GetSize()), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
-	list := make([]*pb.ReviewInfo, 0, len(reviews))
-	for _, v := range reviews {
+	displayUser := s.uc.DisplayUser(ctx)
+	list := make([]*pb.ReviewInfo, 0, len(reviews.List))
+	for _, v := range reviews.List {
+		user := displayUser(v)
 		list = append(list, &pb.ReviewInfo{
 			ReviewID:     v.ReviewID,
-			UserID:       v.UserID,
-			OrderID:      v.OrderID,
+			UserID:       user.UserID,
+			Anonymous:    user.Anonymous,
+			Pseudonym:    user.Pseudonym,
+			OrderID:      user.OrderID,
 			Score:        v.Score,
 			ServiceScore: v.ServiceScore,
 			ExpressScore: v.ExpressScore,
-			Content:      v.Content,
+			Content:      mask(v.Content),
 			PicInfo:      v.PicInfo,
 			VideoInfo:    v.VideoInfo,
-			Status:       v.Status,
+			Media:        toMedia(s.uc.DisplayMedia(v.PicInfo, v.VideoInfo)),
+			Status:       pb.ReviewStatus(v.Status),
+			Version:      v.Version,
 		})
 	}
-	return &pb.ListReviewByUserIDReply{List: list}, nil
+	return &pb.ListReviewByUserIDReply{List: list, NextPageToken: reviews.NextPageToken, Total: reviews.Total}, nil
 }
 
 // ListReviewByStoreID 获取商户所有被评价信息,传入参数为StoreID、Page页码、Size每页的内容条数
 func (s *ReviewService) ListReviewByStoreID(ctx context.Context, req *pb.ListReviewByStoreIDRequest) (*pb.ListReviewByStoreIDReply, error) {
 	fmt.Printf("[service] ListReviewByStoreID req:%v\n", req)
-	myReviews, err := s.uc.ListReviewByStoreID(ctx, req.GetStoreID(), int(req.GetPage()), int(req.GetSize()))
+	// 展示的内容按需打码,运营可以要求返回原文
+	mask, err := s.uc.DisplayMask(ctx, req.GetRaw())
+	if err != nil {
+		return nil, err
+	}
+	myReviews, err := s.uc.ListReviewByStoreID(ctx, req.GetStoreID(), int(req.GetPage()), int(req.GetSize()), req.GetPageToken())
 	if err != nil {
 		return nil, err
 	}
 	// 封装结果返回
-	list := make([]*pb.ReviewInfo, 0, len(myReviews))
-	for _, v := range myReviews {
+	displayUser := s.uc.DisplayUser(ctx)
+	list := make([]*pb.ReviewInfo, 0, len(myReviews.List))
+	for _, v := range myReviews.List {
+		user := displayUser(v.ReviewInfo)
 		list = append(list, &pb.ReviewInfo{
 			ReviewID:     v.ReviewID,
-			UserID:       v.UserID,
-			OrderID:      v.OrderID,
+			UserID:       user.UserID,
+			Anonymous:    user.Anonymous,
+			Pseudonym:    user.Pseudonym,
+			OrderID:      user.OrderID,
 			Score:        v.Score,
 			ServiceScore: v.ServiceScore,
 			ExpressScore: v.ExpressScore,
-			Content:      v.Content,
+			Content:      mask(v.Content),
 			PicInfo:      v.PicInfo,
 			VideoInfo:    v.VideoInfo,
-			Status:       v.Status,
+			Media:        toMedia(s.uc.DisplayMedia(v.PicInfo, v.VideoInfo)),
+			Status:       pb.ReviewStatus(v.Status),
+			Version:      v.Version,
+			Append:       s.toReviewAppend(v.Append, mask),
 		})
 	}
-	return &pb.ListReviewByStoreIDReply{List: list}, nil
+	return &pb.ListReviewByStoreIDReply{List: list, NextPageToken: myReviews.NextPageToken, Total: myReviews.Total}, nil
+}
+
+// SearchReviews 搜索评价,关键词检索评价内容,并支持按商家、商品、评分、状态、时间等条件过滤
+func (s *ReviewService) SearchReviews(ctx context.Context, req *pb.SearchReviewsRequest) (*pb.SearchReviewsReply, error) {
+	param := &biz.SearchParam{
+		Keyword:   req.GetKeyword(),
+		StoreID:   req.StoreID,
+		SpuID:     req.SpuID,
+		SkuID:     req.SkuID,
+		MinScore:  req.MinScore,
+		MaxScore:  req.MaxScore,
+		HasMedia:  req.HasMedia,
+		HasReply:  req.HasReply,
+		Anonymous: req.Anonymous,
+		Sort:      req.GetSort(),
+		PageToken: req.GetPageToken(),
+	}
+	if req.Status != nil {
+		status := int32(req.GetStatus())
+		param.Status = &status
+	}
+	if req.StartTime != nil {
+		t := time.Unix(req.GetStartTime(), 0)
+		param.StartTime = &t
+	}
+	if req.EndTime != nil {
+		t := time.Unix(req.GetEndTime(), 0)
+		param.EndTime = &t
+	}
+	// 展示的内容按需打码,运营可以要求返回原文
+	mask, err := s.uc.DisplayMask(ctx, req.GetRaw())
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.uc.SearchReviews(ctx, param, int(req.GetPage()), int(req.GetSize()))
+	if err != nil {
+		return nil, err
+	}
+	displayUser := s.uc.DisplayUser(ctx)
+	list := make([]*pb.SearchReviewHit, 0, len(result.List))
+	for _, v := range result.List {
+		user := displayUser(v.ReviewInfo)
+		list = append(list, &pb.SearchReviewHit{
+			Review: &pb.ReviewInfo{
+				ReviewID:     v.ReviewID,
+				UserID:       user.UserID,
+				Anonymous:    user.Anonymous,
+				Pseudonym:    user.Pseudonym,
+				OrderID:      user.OrderID,
+				Score:        v.Score,
+				ServiceScore: v.ServiceScore,
+				ExpressScore: v.ExpressScore,
+				Content:      mask(v.Content),
+				PicInfo:      v.PicInfo,
+				VideoInfo:    v.VideoInfo,
+				Media:        toMedia(s.uc.DisplayMedia(v.PicInfo, v.VideoInfo)),
+				Status:       pb.ReviewStatus(v.Status),
+				Version:      v.Version,
+				Append:       s.toReviewAppend(v.Append, mask),
+			},
+			Highlights: maskAll(v.Highlights, mask),
+		})
+	}
+	return &pb.SearchReviewsReply{Total: result.Total, List: list, NextPageToken: result.NextPageToken}, nil
+}
+
+// GetStoreReviewStats 商家的评价统计
+func (s *ReviewService) GetStoreReviewStats(ctx context.Context, req *pb.GetStoreReviewStatsRequest) (*pb.GetStoreReviewStatsReply, error) {
+	stats, err := s.uc.GetStoreReviewStats(ctx, req.GetStoreID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetStoreReviewStatsReply{Stats: toReviewStats(stats)}, nil
+}
+
+// GetSpuReviewStats 商品的评价统计
+func (s *ReviewService) GetSpuReviewStats(ctx context.Context, req *pb.GetSpuReviewStatsRequest) (*pb.GetSpuReviewStatsReply, error) {
+	stats, err := s.uc.GetSpuReviewStats(ctx, req.GetSpuID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSpuReviewStatsReply{Stats: toReviewStats(stats)}, nil
+}
+
+// CreateMediaUpload 申请上传图片或视频,返回预签名的上传地址
+func (s *ReviewService) CreateMediaUpload(ctx context.Context, req *pb.CreateMediaUploadRequest) (*pb.CreateMediaUploadReply, error) {
+	upload, err := s.uc.CreateMediaUpload(ctx, &biz.UploadParam{
+		Type:        int32(req.GetType()),
+		ContentType: req.GetContentType(),
+		Size:        req.GetSize(),
+		Duration:    req.GetDuration(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateMediaUploadReply{
+		MediaID:   upload.MediaID,
+		ObjectKey: upload.ObjectKey,
+		UploadUrl: upload.URL,
+		Method:    upload.Method,
+		Headers:   upload.Headers,
+		ExpireAt:  upload.ExpireAt.Unix(),
+	}, nil
+}
+
+// CompleteMediaUpload 确认上传完成,返回校验之后的媒体信息
+func (s *ReviewService) CompleteMediaUpload(ctx context.Context, req *pb.CompleteMediaUploadRequest) (*pb.CompleteMediaUploadReply, error) {
+	media, err := s.uc.CompleteMediaUpload(ctx, req.GetMediaID())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CompleteMediaUploadReply{Media: toMedia([]*biz.Media{media})[0]}, nil
+}
+
+func (s *ReviewService) toReviewAppend(a *model.ReviewAppendInfo, mask func(string) string) *pb.ReviewAppend {
+	if a == nil {
+		return nil
+	}
+	return &pb.ReviewAppend{
+		AppendID:  a.AppendID,
+		Content:   mask(a.Content),
+		PicInfo:   a.PicInfo,
+		VideoInfo: a.VideoInfo,
+		Media:     toMedia(s.uc.DisplayMedia(a.PicInfo, a.VideoInfo)),
+		Status:    pb.ReviewStatus(a.Status),
+		CreateAt:  a.CreateAt.Unix(),
+	}
+}
+
+func fromMedia(list []*pb.Media) []*biz.Media {
+	ret := make([]*biz.Media, 0, len(list))
+	for _, v := range list {
+		ret = append(ret, &biz.Media{
+			Type:        int32(v.Type),
+			URL:         v.Url,
+			ObjectKey:   v.ObjectKey,
+			Width:       v.Width,
+			Height:      v.Height,
+			Duration:    v.Duration,
+			ContentHash: v.ContentHash,
+			Size:        v.Size,
+		})
+	}
+	return ret
+}
+
+func toMedia(list []*biz.Media) []*pb.Media {
+	ret := make([]*pb.Media, 0, len(list))
+	for _, v := range list {
+		ret = append(ret, &pb.Media{
+			Type:         pb.MediaType(v.Type),
+			Url:          v.URL,
+			ObjectKey:    v.ObjectKey,
+			Width:        v.Width,
+			Height:       v.Height,
+			Duration:     v.Duration,
+			ContentHash:  v.ContentHash,
+			Size:         v.Size,
+			ThumbnailUrl: v.ThumbnailURL,
+		})
+	}
+	return ret
+}
+
+func maskAll(list []string, mask func(string) string) []string {
+	ret := make([]string, 0, len(list))
+	for _, v := range list {
+		ret = append(ret, mask(v))
+	}
+	return ret
+}
+
+func toReviewStats(stats *biz.ReviewStats) *pb.ReviewStats {
+	return &pb.ReviewStats{
+		Count:           stats.Count,
+		AvgScore:        stats.AvgScore,
+		AvgServiceScore: stats.AvgServiceScore,
+		AvgExpressScore: stats.AvgExpressScore,
+		ScoreHistogram:  stats.ScoreHistogram[:],
+		MediaRatio:      stats.MediaRatio,
+		ReplyRatio:      stats.ReplyRatio,
+		GoodRate:        stats.GoodRate,
+	}
 }
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+/* 该pkg定义调用方的身份
+server层的认证中间件校验JWT之后把身份放入ctx,biz层从ctx中获取身份,不再信任请求参数中的userID/storeID/opUser
+*/
+
+// Role 调用方的角色
+type Role string
+
+const (
+	RoleConsumer Role = "consumer" // C端用户
+	RoleMerchant Role = "merchant" // B端商家
+	RoleOperator Role = "operator" // O端运营
+)
+
+// Valid 是否是认识的角色
+func (r Role) Valid() bool {
+	switch r {
+	case RoleConsumer, RoleMerchant, RoleOperator:
+		return true
+	}
+	return false
+}
+
+// Claims JWT中的字段,sub为调用方的账号
+type Claims struct {
+	Role    Role  `json:"role"`
+	UserID  int64 `json:"user_id,omitempty"`  // 用户ID,C端用户必须有
+	StoreID int64 `json:"store_id,omitempty"` // 商家ID,B端商家必须有
+	jwt.RegisteredClaims
+}
+
+// Identity 调用方的身份
+type Identity struct {
+	Subject string // 账号,运营审核时记录为操作人
+	Role    Role
+	UserID  int64
+	StoreID int64
+}
+
+type identityKey struct{}
+
+// NewContext 把身份放入ctx
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext 从ctx中获取身份
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
@@ -0,0 +1,275 @@
+package objectstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PathPrefix fs存储对外提供上传、下载的路径前缀
+const PathPrefix = "/objects/"
+
+// FS 保存在本地目录的对象存储,用于开发和测试
+// 同时实现了http.Handler:PUT按预签名上传,GET/HEAD公开读取,挂在HTTP服务的PathPrefix下
+// 能否上传、下载时的Content-Type以catalog中的记录为准,没有记录的对象不能上传和下载
+type FS struct {
+	root    string
+	baseURL string
+	secret  []byte
+	catalog Catalog
+}
+
+var _ Store = (*FS)(nil)
+
+func NewFS(root, baseURL, secret string, catalog Catalog) (*FS, error) {
+	if root == "" || secret == "" {
+		return nil, errors.New("objectstore fs: root and secret are required")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("objectstore fs: create root %s failed, err:%w", root, err)
+	}
+	if catalog == nil {
+		return nil, errors.New("objectstore fs: catalog is required")
+	}
+	return &FS{root: root, baseURL: strings.TrimRight(baseURL, "/"), secret: []byte(secret), catalog: catalog}, nil
+}
+
+func (s *FS) PresignPut(_ context.Context, key string, opts PutOptions) (*PresignedRequest, error) {
+	if !ValidKey(key) {
+		return nil, ErrInvalidKey
+	}
+	expireAt := time.Now().Add(opts.Expires)
+	expires := strconv.FormatInt(expireAt.Unix(), 10)
+	maxSize := strconv.FormatInt(opts.MaxSize, 10)
+	q := url.Values{}
+	q.Set("expires", expires)
+	q.Set("max", maxSize)
+	q.Set("signature", s.sign(key, expires, maxSize, opts.ContentType))
+	req := &PresignedRequest{
+		Method:   http.MethodPut,
+		URL:      s.URL(key) + "?" + q.Encode(),
+		Headers:  map[string]string{},
+		ExpireAt: expireAt,
+	}
+	if opts.ContentType != "" {
+		req.Headers["Content-Type"] = opts.ContentType
+	}
+	return req, nil
+}
+
+func (s *FS) Head(_ context.Context, key string) (*ObjectInfo, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := os.Stat(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{Key: key, Size: fi.Size(), ModTime: fi.ModTime()}, nil
+}
+
+func (s *FS) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+func (s *FS) Put(_ context.Context, key string, r io.Reader, _ string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	_, err = writeFile(p, r, true)
+	return err
+}
+
+func (s *FS) Delete(_ context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *FS) URL(key string) string {
+	return s.baseURL + PathPrefix + key
+}
+
+func (s *FS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, PathPrefix)
+	p, err := s.path(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// 以.开头的是上传时的临时文件
+	for _, seg := range strings.Split(key, "/") {
+		if strings.HasPrefix(seg, ".") {
+			http.NotFound(w, r)
+			return
+		}
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		s.download(w, r, key, p)
+	case http.MethodPut:
+		s.upload(w, r, key, p)
+	default:
+		w.Header().Set("Allow", "GET, HEAD, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// download 只返回已经上传完成的普通文件,Content-Type使用catalog中记录的类型,不让浏览器猜测
+func (s *FS) download(w http.ResponseWriter, r *http.Request, key, p string) {
+	meta, err := s.catalog.Lookup(r.Context(), key)
+	if errors.Is(err, ErrNotFound) || (err == nil && meta.Uploadable) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, os.ErrNotExist) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !fi.Mode().IsRegular() {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", meta.ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, "", fi.ModTime(), f)
+}
+
+// upload 校验签名和有效期,按签名中的大小上限写入文件
+// 只有等待上传的对象可以上传,并且只能上传一次,已经存在的文件不会被覆盖
+func (s *FS) upload(w http.ResponseWriter, r *http.Request, key, p string) {
+	q := r.URL.Query()
+	expires, maxSize := q.Get("expires"), q.Get("max")
+	sig := s.sign(key, expires, maxSize, r.Header.Get("Content-Type"))
+	if !hmac.Equal([]byte(sig), []byte(q.Get("signature"))) {
+		http.Error(w, "signature does not match", http.StatusForbidden)
+		return
+	}
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > exp {
+		http.Error(w, "request has expired", http.StatusForbidden)
+		return
+	}
+	limit, err := strconv.ParseInt(maxSize, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid max size", http.StatusBadRequest)
+		return
+	}
+	meta, err := s.catalog.Lookup(r.Context(), key)
+	if errors.Is(err, ErrNotFound) || (err == nil && !meta.Uploadable) {
+		http.Error(w, "object is not awaiting upload", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if limit > 0 {
+		if r.ContentLength > limit {
+			http.Error(w, "entity too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+	}
+	if _, err := writeFile(p, r.Body, false); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "entity too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if errors.Is(err, ErrExists) {
+			http.Error(w, "object already exists", http.StatusConflict)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *FS) sign(key, expires, maxSize, contentType string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(strings.Join([]string{http.MethodPut, key, expires, maxSize, contentType}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *FS) path(key string) (string, error) {
+	if !ValidKey(key) {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// writeFile 先写入临时文件再重命名,读取方不会看到写了一半的文件
+// overwrite为false时通过硬链接代替重命名,文件已经存在时返回ErrExists,并发上传也只有一个能成功
+func writeFile(p string, r io.Reader, overwrite bool) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return 0, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+	n, err := io.Copy(tmp, r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return n, err
+	}
+	if overwrite {
+		return n, os.Rename(tmp.Name(), p)
+	}
+	if err := os.Link(tmp.Name(), p); err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return n, ErrExists
+		}
+		return n, err
+	}
+	return n, nil
+}
@@ -0,0 +1,151 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// catalog 测试用的对象记录
+type catalog map[string]*ObjectMeta
+
+func (c catalog) Lookup(_ context.Context, key string) (*ObjectMeta, error) {
+	if m, ok := c[key]; ok {
+		return m, nil
+	}
+	return nil, ErrNotFound
+}
+
+func newTestFS(t *testing.T, c catalog) (*FS, *httptest.Server) {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(nil)
+	s, err := NewFS(t.TempDir(), "http://"+srv.Listener.Addr().String(), "secret", c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(PathPrefix, s)
+	srv.Config.Handler = mux
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return s, srv
+}
+
+func put(t *testing.T, url, contentType, body string) int {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPut, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestFSUpload(t *testing.T) {
+	c := catalog{"media/1": {ContentType: "image/png", Uploadable: true}}
+	s, _ := newTestFS(t, c)
+	ctx := context.Background()
+	presign := func(key string, expires time.Duration) string {
+		req, err := s.PresignPut(ctx, key, PutOptions{ContentType: "image/png", MaxSize: 10, Expires: expires})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req.URL
+	}
+	url := presign("media/1", time.Minute)
+
+	tests := []struct {
+		name        string
+		url         string
+		contentType string
+		body        string
+		want        int
+	}{
+		{"bad signature", strings.Replace(url, "signature=", "signature=0", 1), "image/png", "png", http.StatusForbidden},
+		{"content type not signed", url, "text/html", "png", http.StatusForbidden},
+		{"expired", presign("media/1", -time.Second), "image/png", "png", http.StatusForbidden},
+		{"too large", url, "image/png", strings.Repeat("a", 11), http.StatusRequestEntityTooLarge},
+		{"no record", presign("media/2", time.Minute), "image/png", "png", http.StatusConflict},
+		{"ok", url, "image/png", "png", http.StatusOK},
+		{"overwrite", url, "image/png", "new", http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := put(t, tt.url, tt.contentType, tt.body); got != tt.want {
+				t.Errorf("PUT status = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	// 请求的路径不经过ServeMux的清理,直接交给FS
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, PathPrefix+"media/../../etc/passwd", strings.NewReader("png")))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("PUT with .. key status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+
+	// 确认上传完成之后,即使文件被删除也不能再上传
+	c["media/1"].Uploadable = false
+	if err := s.Delete(ctx, "media/1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := put(t, url, "image/png", "new"); got != http.StatusConflict {
+		t.Errorf("PUT after completion status = %d, want %d", got, http.StatusConflict)
+	}
+}
+
+func TestFSDownload(t *testing.T) {
+	c := catalog{
+		"media/1":    {ContentType: "image/png"},
+		"media/2":    {ContentType: "image/png", Uploadable: true},
+		"media/3":    {ContentType: "image/png"},
+		"media/.tmp": {ContentType: "image/png"},
+		"media":      {ContentType: "image/png"},
+	}
+	s, srv := newTestFS(t, c)
+	ctx := context.Background()
+	for _, key := range []string{"media/1", "media/2", "media/.tmp"} {
+		if err := s.Put(ctx, key, strings.NewReader("<html><script>alert(1)</script></html>"), ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resp, err := http.Get(srv.URL + PathPrefix + "media/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	// 以记录的类型返回,不按内容猜测为html
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "image/png" ||
+		resp.Header.Get("X-Content-Type-Options") != "nosniff" || !strings.HasPrefix(string(body), "<html>") {
+		t.Errorf("GET media/1 = %d %q %q", resp.StatusCode, resp.Header.Get("Content-Type"), resp.Header.Get("X-Content-Type-Options"))
+	}
+
+	for _, key := range []string{
+		"media/2",    // 等待上传
+		"media/3",    // 文件不存在
+		"media/4",    // 没有记录
+		"media/.tmp", // 临时文件
+		"media",      // 目录
+		"media/",
+	} {
+		resp, err := http.Get(srv.URL + PathPrefix + key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("GET %s status = %d, want %d", key, resp.StatusCode, http.StatusNotFound)
+		}
+	}
+}
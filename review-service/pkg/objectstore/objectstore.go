@@ -0,0 +1,73 @@
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotFound   = errors.New("object not found")
+	ErrInvalidKey = errors.New("invalid object key")
+	ErrExists     = errors.New("object already exists")
+)
+
+// Store S3兼容的对象存储
+// 上传走预签名地址,文件不经过业务接口;业务只在确认上传时读取文件做校验
+type Store interface {
+	// PresignPut 生成预签名的上传请求,调用方在过期之前按返回的方法、地址和请求头上传,不需要凭证
+	PresignPut(ctx context.Context, key string, opts PutOptions) (*PresignedRequest, error)
+	// Head 查询对象的信息,对象不存在时返回ErrNotFound
+	Head(ctx context.Context, key string) (*ObjectInfo, error)
+	// Get 读取对象,对象不存在时返回ErrNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Put 服务端直接写入对象(例如缩略图)
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Delete 删除对象,对象不存在时不报错
+	Delete(ctx context.Context, key string) error
+	// URL 对象的访问地址
+	URL(key string) string
+}
+
+// PutOptions 预签名上传的限制
+type PutOptions struct {
+	ContentType string        // 上传时必须带上的Content-Type
+	MaxSize     int64         // 文件大小上限(字节),S3的预签名PUT无法限制大小,确认上传时需要再校验一次
+	Expires     time.Duration // 上传地址的有效期
+}
+
+// PresignedRequest 预签名的上传请求
+type PresignedRequest struct {
+	Method   string
+	URL      string
+	Headers  map[string]string
+	ExpireAt time.Time
+}
+
+// ObjectMeta 业务中记录的对象信息
+type ObjectMeta struct {
+	ContentType string // 下载时返回的Content-Type,以业务确认的类型为准,不从文件内容猜测
+	Uploadable  bool   // 是否还在等待上传;等待上传时不能下载,上传完成之后不能再覆盖
+}
+
+// Catalog 查询业务中记录的对象信息,没有记录时返回ErrNotFound
+type Catalog interface {
+	Lookup(ctx context.Context, key string) (*ObjectMeta, error)
+}
+
+// ObjectInfo 对象的信息
+type ObjectInfo struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9/_.-]{0,255}$`)
+
+// ValidKey key只能包含字母、数字和/_.-,并且不能有..,避免fs存储访问到root之外的文件
+func ValidKey(key string) bool {
+	return keyPattern.MatchString(key) && !strings.Contains(key, "..")
+}
@@ -0,0 +1,188 @@
+package sensitive
+
+import (
+	"unicode"
+)
+
+/* 该pkg使用Aho-Corasick自动机在文本中查找敏感词
+一次扫描即可找出所有敏感词,耗时与文本长度成正比,与词典大小无关
+匹配时忽略大小写,并跳过空白和标点,"傻 * 瓜"也能命中"傻瓜"
+*/
+
+// Match 一次命中,Start、End为命中的片段在原文中的rune下标[Start, End)
+type Match struct {
+	Word  string
+	Start int
+	End   int
+}
+
+type node struct {
+	next  map[rune]int
+	fail  int
+	depth int
+	word  int // 以该节点结尾的词在words中的下标,-1表示不是词尾
+	out   int // 沿fail链最近的词尾节点,-1表示没有
+}
+
+// Matcher Aho-Corasick自动机,构建之后只读,可以并发使用
+type Matcher struct {
+	nodes []node
+	words []string
+}
+
+// New 用词典构建自动机,空词和重复的词会被忽略
+func New(words []string) *Matcher {
+	m := &Matcher{nodes: []node{newNode(0)}}
+	for _, w := range words {
+		m.insert(w)
+	}
+	m.build()
+	return m
+}
+
+// Len 词典中词的个数
+func (m *Matcher) Len() int {
+	return len(m.words)
+}
+
+// FindAll 找出文本中所有的敏感词,同一位置可能命中多个词(例如"傻瓜"和"瓜")
+func (m *Matcher) FindAll(text string) []Match {
+	if len(m.words) == 0 {
+		return nil
+	}
+	var (
+		matches []Match
+		pos     []int // 送入自动机的rune在原文中的下标,用来还原命中片段的起点
+		state   int
+		i       = -1
+	)
+	for _, r := range text {
+		i++
+		if skip(r) {
+			continue
+		}
+		r = unicode.ToLower(r)
+		pos = append(pos, i)
+		state = m.step(state, r)
+		for n := state; n > 0; n = m.nodes[n].out {
+			if m.nodes[n].word < 0 {
+				continue
+			}
+			matches = append(matches, Match{
+				Word:  m.words[m.nodes[n].word],
+				Start: pos[len(pos)-m.nodes[n].depth],
+				End:   i + 1,
+			})
+		}
+	}
+	return matches
+}
+
+// Contains 文本中是否有敏感词
+func (m *Matcher) Contains(text string) bool {
+	if len(m.words) == 0 {
+		return false
+	}
+	state := 0
+	for _, r := range text {
+		if skip(r) {
+			continue
+		}
+		state = m.step(state, unicode.ToLower(r))
+		if m.nodes[state].word >= 0 || m.nodes[state].out > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func newNode(depth int) node {
+	return node{next: make(map[rune]int), depth: depth, word: -1, out: -1}
+}
+
+func (m *Matcher) insert(w string) {
+	cur, depth := 0, 0
+	for _, r := range w {
+		if skip(r) {
+			continue
+		}
+		r = unicode.ToLower(r)
+		depth++
+		nxt, ok := m.nodes[cur].next[r]
+		if !ok {
+			nxt = len(m.nodes)
+			m.nodes = append(m.nodes, newNode(depth))
+			m.nodes[cur].next[r] = nxt
+		}
+		cur = nxt
+	}
+	if cur == 0 || m.nodes[cur].word >= 0 {
+		return
+	}
+	m.nodes[cur].word = len(m.words)
+	m.words = append(m.words, w)
+}
+
+// build 按层次遍历计算fail指针和输出链
+func (m *Matcher) build() {
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f > 0 {
+				if _, ok := m.nodes[f].next[r]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if nxt, ok := m.nodes[f].next[r]; ok && nxt != child {
+				m.nodes[child].fail = nxt
+			}
+			fail := m.nodes[child].fail
+			if m.nodes[fail].word >= 0 {
+				m.nodes[child].out = fail
+			} else {
+				m.nodes[child].out = m.nodes[fail].out
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+func (m *Matcher) step(state int, r rune) int {
+	for {
+		if nxt, ok := m.nodes[state].next[r]; ok {
+			return nxt
+		}
+		if state == 0 {
+			return 0
+		}
+		state = m.nodes[state].fail
+	}
+}
+
+// skip 匹配时跳过的字符:空白、标点和符号
+func skip(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// Mask 把命中的片段替换为mask,片段中间的空白和标点保持不变
+func Mask(text string, matches []Match, mask rune) string {
+	if len(matches) == 0 {
+		return text
+	}
+	runes := []rune(text)
+	for _, m := range matches {
+		for i := m.Start; i < m.End && i < len(runes); i++ {
+			if !skip(runes[i]) {
+				runes[i] = mask
+			}
+		}
+	}
+	return string(runes)
+}
@@ -0,0 +1,79 @@
+package sensitive
+
+import "testing"
+
+func TestMatcherFindAll(t *testing.T) {
+	m := New([]string{"he", "she", "hers", "his"})
+	got := m.FindAll("uSHErs")
+	want := []Match{
+		{Word: "she", Start: 1, End: 4},
+		{Word: "he", Start: 2, End: 4},
+		{Word: "hers", Start: 2, End: 6},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("FindAll() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("FindAll()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMatcherSkipPunctuation(t *testing.T) {
+	m := New([]string{"傻瓜"})
+	// 下标是rune下标,跳过的空白和标点算在命中片段中
+	got := m.FindAll("你这个傻 * 瓜!")
+	want := Match{Word: "傻瓜", Start: 3, End: 8}
+	if len(got) != 1 || got[0] != want {
+		t.Fatalf("FindAll() = %v, want [%v]", got, want)
+	}
+	if s := Mask("你这个傻 * 瓜!", got, '*'); s != "你这个* * *!" {
+		t.Errorf("Mask() = %q", s)
+	}
+}
+
+func TestMatcherContains(t *testing.T) {
+	m := New([]string{"刷单", "hers"})
+	tests := []struct {
+		text string
+		want bool
+	}{
+		{"可以刷单吗", true},
+		{"可以刷,单吗", true},
+		{"HERS", true},
+		{"he rs", true},
+		{"刷好评", false},
+		{"her", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := m.Contains(tt.text); got != tt.want {
+			t.Errorf("Contains(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+	if New(nil).Contains("刷单") {
+		t.Error("empty Matcher Contains() = true, want false")
+	}
+}
+
+func TestMask(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		matches []Match
+		want    string
+	}{
+		{"没有命中", "hello", nil, "hello"},
+		{"重叠的命中", "ushers", []Match{{Start: 1, End: 4}, {Start: 2, End: 6}}, "u*****"},
+		{"保留中间的标点", "a-b-c", []Match{{Start: 0, End: 5}}, "*-*-*"},
+		{"越界的下标", "ab", []Match{{Start: 1, End: 5}}, "a*"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mask(tt.text, tt.matches, '*'); got != tt.want {
+				t.Errorf("Mask() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
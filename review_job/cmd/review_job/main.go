@@ -1,8 +1,14 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"review_job/internal/conf"
 	"review_job/internal/job"
@@ -26,15 +32,21 @@ var (
 	Version string
 	// flagconf is the config flag.
 	flagconf string
+	// flagidle is the replay idle timeout flag.
+	flagidle time.Duration
+	// flagdrop is the reindex drop index confirmation flag.
+	flagdrop bool
 
 	id, _ = os.Hostname()
 )
 
 func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+	flag.DurationVar(&flagidle, "idle", 10*time.Second, "replay子命令:死信队列持续多久没有新消息时退出")
+	flag.BoolVar(&flagdrop, "drop-index", false, "reindex子命令:确认删除与别名同名的真实索引(第一次reindex时由JobWorker自动创建),不确认时拒绝切换别名")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *job.JobWorker) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *job.JobWorker, rc *job.Reconciler) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,14 +55,26 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, js *job.JobWork
 		kratos.Logger(logger),
 		kratos.Server(
 			// gs,
-			// hs,
+			hs, // 对账结果
 			js, //job server
+			rc, // 对账
 		),
 	)
 }
 
+// 用法:
+//
+//	review_job [-conf ../../configs]           启动评价数据同步任务
+//	review_job replay [-conf ../../configs]    把死信队列中的消息重新投递回原topic
+//	review_job reindex [-conf ../../configs] [-drop-index]
+//	                                          从MySQL全量重建ES索引,完成后切换别名
+//	                                          第一次执行时与别名同名的索引是真实索引,切换时会被删除,需要-drop-index确认
 func main() {
-	flag.Parse()
+	cmd, args := "run", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		cmd, args = args[0], args[1:]
+	}
+	flag.CommandLine.Parse(args)
 	logger := log.With(log.NewStdLogger(os.Stdout),
 		"ts", log.DefaultTimestamp,
 		"caller", log.DefaultCaller,
@@ -76,7 +100,19 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Kafka, bc.GetEs(), logger)
+	switch cmd {
+	case "run":
+	case "replay":
+		replay(bc.Kafka, logger)
+		return
+	case "reindex":
+		reindex(bc.Data.GetDatabase(), bc.GetEs(), logger)
+		return
+	default:
+		panic("unknown command: " + cmd)
+	}
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Kafka, bc.GetEs(), bc.GetReconcile(), logger)
 	if err != nil {
 		panic(err)
 	}
@@ -87,3 +123,25 @@ func main() {
 		panic(err)
 	}
 }
+
+// replay 重放死信队列,收到退出信号时停止
+func replay(cfg *conf.Kafka, logger log.Logger) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	n, err := job.Replay(ctx, cfg, flagidle, logger)
+	log.NewHelper(logger).Infof("replayed %d messages from %s", n, cfg.GetDlqTopic())
+	if err != nil && !errors.Is(err, context.Canceled) {
+		panic(err)
+	}
+}
+
+// reindex 全量重建ES索引,收到退出信号时停止,此时别名不会切换
+func reindex(db *conf.Data_Database, cfg *conf.ES, logger log.Logger) {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	index, err := job.Reindex(ctx, db, cfg, flagdrop, logger)
+	if err != nil {
+		panic(err)
+	}
+	log.NewHelper(logger).Infof("reindex finished, alias %s -> %s", cfg.GetIndex(), index)
+}
@@ -24,7 +24,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, kafka *conf.Kafka, es *conf.ES, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, kafka *conf.Kafka, es *conf.ES, reconcile *conf.Reconcile, logger log.Logger) (*kratos.App, func(), error) {
 	dataData, cleanup, err := data.NewData(confData, logger)
 	if err != nil {
 		return nil, nil, err
@@ -33,16 +33,26 @@ func wireApp(confServer *conf.Server, confData *conf.Data, kafka *conf.Kafka, es
 	greeterUsecase := biz.NewGreeterUsecase(greeterRepo, logger)
 	greeterService := service.NewGreeterService(greeterUsecase)
 	grpcServer := server.NewGRPCServer(confServer, greeterService, logger)
-	httpServer := server.NewHTTPServer(confServer, greeterService, logger)
-	reader := job.NewKafkaReader(kafka)
+	db, cleanup2, err := job.NewDB(confData)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	esClient, err := job.NewESClient(es)
 	if err != nil {
+		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	jobWorker := job.NewJobWorker(reader, esClient, logger)
-	app := newApp(logger, grpcServer, httpServer, jobWorker)
+	reconciler := job.NewReconciler(db, esClient, reconcile, logger)
+	httpServer := server.NewHTTPServer(confServer, greeterService, reconciler, logger)
+	reader := job.NewKafkaReader(kafka)
+	writer := job.NewDLQWriter(kafka)
+	client := job.NewRedis(confData)
+	jobWorker := job.NewJobWorker(reader, writer, esClient, client, logger)
+	app := newApp(logger, grpcServer, httpServer, jobWorker, reconciler)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -7,6 +7,8 @@ toolchain go1.22.0
 require (
 	github.com/elastic/go-elasticsearch/v8 v8.14.0
 	github.com/go-kratos/kratos/v2 v2.7.2
+	github.com/go-redis/redis v6.15.9+incompatible
+	github.com/go-sql-driver/mysql v1.7.0
 	github.com/google/wire v0.5.0
 	github.com/segmentio/kafka-go v0.4.47
 	go.uber.org/automaxprocs v1.5.1
@@ -0,0 +1,211 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/refresh"
+	"github.com/segmentio/kafka-go"
+)
+
+// bulk中的文档操作类型
+const (
+	opUpsert = "upsert" // 局部更新,文档不存在时新建
+	opUpdate = "update" // 局部更新,文档不存在时忽略
+	opDelete = "delete"
+)
+
+// bulkAction 一个文档操作,对应canal消息中的一行数据
+type bulkAction struct {
+	msg   int // 来源消息在批次中的下标
+	op    string
+	id    string
+	store string // 评价所属的商家,用于让商家的评价缓存失效
+	spu   string // 评价所属的商品,只有review_info有,用于让商品的评价统计缓存失效
+	doc   map[string]interface{}
+}
+
+// batch 一批待写入ES的消息
+type batch struct {
+	msgs    []kafka.Message
+	actions []bulkAction
+	bytes   int
+}
+
+// addMessage 把消息加入批次,返回消息在批次中的下标
+func (b *batch) addMessage(m kafka.Message) int {
+	b.msgs = append(b.msgs, m)
+	b.bytes += len(m.Value)
+	return len(b.msgs) - 1
+}
+
+func (b *batch) reset() {
+	b.msgs = b.msgs[:0]
+	b.actions = b.actions[:0]
+	b.bytes = 0
+}
+
+// flush 把批次中的文档操作通过_bulk写入ES,然后提交这批消息的offset
+// 可重试的失败按退避策略重试,最终失败的文档操作所属的消息写入死信队列
+// 只有ctx被取消时才返回错误,此时不提交offset,下次启动后重新消费
+func (jw JobWorker) flush(ctx context.Context, b *batch) error {
+	if len(b.msgs) == 0 {
+		return nil
+	}
+	failures := make(map[int][]string) // 消息下标 -> 失败原因
+	if len(b.actions) > 0 {
+		pending, attempts, err := jw.write(ctx, b.actions, failures, true)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// 重试次数用完仍然失败的文档操作
+		if err != nil {
+			for _, a := range pending {
+				failures[a.msg] = append(failures[a.msg], fmt.Sprintf("%s %s: %v", a.op, a.id, err))
+			}
+		}
+		for idx, m := range b.msgs {
+			if reasons, ok := failures[idx]; ok {
+				jw.deadLetter(ctx, m, errors.New(strings.Join(reasons, "; ")), attempts)
+			}
+		}
+		jw.invalidate(b.actions)
+	}
+	// 这批消息要么已经写入ES、要么已经进入死信队列,可以提交offset了
+	if err := jw.kafkaReader.CommitMessages(ctx, b.msgs...); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		jw.log.Errorf("commit messages failed, err:%v", err)
+	}
+	jw.log.Debugf("flushed %d messages, %d actions, %d failed", len(b.msgs), len(b.actions), len(failures))
+	b.reset()
+	return nil
+}
+
+// write 写入一批文档操作,可重试的失败按退避策略重试
+// 返回重试次数用完仍然失败的文档操作、总共尝试的次数以及最后一次的错误,不可重试的失败记录到failures
+// wait为true时等文档可以被搜索到再返回,只有写入后要让缓存失效的增量同步需要;reindex、对账的批量写入依赖索引自身的refresh_interval
+func (jw JobWorker) write(ctx context.Context, actions []bulkAction, failures map[int][]string, wait bool) ([]bulkAction, int, error) {
+	pending := actions
+	attempts, err := jw.retry(ctx, func(ctx context.Context) error {
+		retry, err := jw.bulk(ctx, pending, failures, wait)
+		if err != nil {
+			return err
+		}
+		if pending = retry; len(pending) > 0 {
+			return fmt.Errorf("%d actions need retry", len(pending))
+		}
+		return nil
+	})
+	return pending, attempts, err
+}
+
+// bulk 发送一次_bulk请求
+// 请求整体失败时返回错误;否则逐条检查结果,按原来的顺序返回需要重试的文档操作,不可重试的失败记录到failures
+func (jw JobWorker) bulk(ctx context.Context, actions []bulkAction, failures map[int][]string, wait bool) ([]bulkAction, error) {
+	docAsUpsert := true
+	req := jw.esClient.client.Bulk().Index(jw.esClient.index)
+	if wait {
+		// 等文档可以被搜索到再返回,之后让缓存失效时,review-service重新查询ES能读到最新的数据
+		req.Refresh(refresh.Waitfor)
+	}
+	for _, a := range actions {
+		id := a.id
+		var err error
+		switch a.op {
+		case opUpsert:
+			err = req.UpdateOp(types.UpdateOperation{Id_: &id}, a.doc, &types.UpdateAction{DocAsUpsert: &docAsUpsert})
+		case opUpdate:
+			err = req.UpdateOp(types.UpdateOperation{Id_: &id}, a.doc, &types.UpdateAction{})
+		case opDelete:
+			err = req.DeleteOp(types.DeleteOperation{Id_: &id})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := req.Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bulk request failed, err:%w", err)
+	}
+	if len(resp.Items) != len(actions) {
+		return nil, fmt.Errorf("bulk response has %d items, want %d", len(resp.Items), len(actions))
+	}
+	var retry []bulkAction
+	// 有操作需要重试的文档:同一批中这个文档后面的操作不管结果如何都要按顺序一起重试
+	// 否则重试时先前的操作会覆盖后面已经成功的操作,例如删除的文档被重新写入
+	retryIDs := make(map[string]bool)
+	for i, item := range resp.Items {
+		a := actions[i]
+		if retryIDs[a.id] {
+			retry = append(retry, a)
+			continue
+		}
+		for _, r := range item {
+			// 删除或更新不存在的文档视为成功,见document
+			if r.Status < 300 || ((a.op == opDelete || a.op == opUpdate) && r.Status == 404) {
+				continue
+			}
+			reason := fmt.Sprintf("status:%d", r.Status)
+			if r.Error != nil {
+				reason = fmt.Sprintf("%s %s", reason, r.Error.Type)
+				if r.Error.Reason != nil {
+					reason = fmt.Sprintf("%s: %s", reason, *r.Error.Reason)
+				}
+			}
+			jw.log.Errorf("bulk %s document %s failed, %s", a.op, a.id, reason)
+			// 限流和服务端错误可以重试,其他错误(例如mapping冲突)重试也不会成功
+			if r.Status == 429 || r.Status >= 500 {
+				retryIDs[a.id] = true
+				retry = append(retry, a)
+			} else {
+				failures[a.msg] = append(failures[a.msg], fmt.Sprintf("%s %s %s", a.op, a.id, reason))
+			}
+		}
+	}
+	return retry, nil
+}
+
+// 商家、商品评价缓存的代数,和review-service中的key格式保持一致
+const (
+	storeGenerationKey = "review:gen:%s"
+	spuGenerationKey   = "review:gen:spu:%s"
+)
+
+// invalidate 把这批文档涉及的商家、商品的缓存代数加一,review-service之后会用新的key查询ES
+// 失败只记录日志,缓存最多在过期之前是旧的
+func (jw JobWorker) invalidate(actions []bulkAction) {
+	if jw.rdb == nil {
+		return
+	}
+	keys := make(map[string]bool)
+	pipe := jw.rdb.Pipeline()
+	for _, a := range actions {
+		// 评价、回复、申诉三张表都有store_id,只有评价表有spu_id,缺失时跳过
+		for _, key := range []string{generationKey(storeGenerationKey, a.store), generationKey(spuGenerationKey, a.spu)} {
+			if key == "" || keys[key] {
+				continue
+			}
+			keys[key] = true
+			pipe.Incr(key)
+		}
+	}
+	if len(keys) == 0 {
+		return
+	}
+	if _, err := pipe.Exec(); err != nil {
+		jw.log.Errorf("bump %d cache generations failed, err:%v", len(keys), err)
+	}
+}
+
+// generationKey 缓存代数的key,id为空时返回空
+func generationKey(format, id string) string {
+	if id == "" {
+		return ""
+	}
+	return fmt.Sprintf(format, id)
+}
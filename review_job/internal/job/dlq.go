@@ -0,0 +1,105 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"review_job/internal/conf"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/segmentio/kafka-go"
+)
+
+// DeadLetter 写入死信队列的消息
+// 保留原始消息的内容和位置,以及失败原因和尝试次数,方便排查后重放
+type DeadLetter struct {
+	Topic     string    `json:"topic"`
+	Partition int       `json:"partition"`
+	Offset    int64     `json:"offset"`
+	Key       string    `json:"key"`
+	Payload   string    `json:"payload"` // 原始消息
+	Reason    string    `json:"reason"`  // 失败原因
+	Attempts  int       `json:"attempts"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+// deadLetter 把处理失败的消息写入死信队列
+func (jw JobWorker) deadLetter(ctx context.Context, m kafka.Message, cause error, attempts int) {
+	jw.log.Errorf("message %v/%v/%v failed after %d attempts, send to dlq, err:%v", m.Topic, m.Partition, m.Offset, attempts, cause)
+	value, err := json.Marshal(DeadLetter{
+		Topic:     m.Topic,
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Key:       string(m.Key),
+		Payload:   string(m.Value),
+		Reason:    cause.Error(),
+		Attempts:  attempts,
+		FailedAt:  time.Now(),
+	})
+	if err != nil {
+		jw.log.Errorf("marshal dead letter failed, err:%v", err)
+		return
+	}
+	if _, err := jw.retry(ctx, func(ctx context.Context) error {
+		return jw.dlqWriter.WriteMessages(ctx, kafka.Message{Key: m.Key, Value: value})
+	}); err != nil {
+		// 死信队列也写不进去,只能记录日志,由人工根据日志处理
+		jw.log.Errorf("write dead letter failed, payload:%s, err:%v", m.Value, err)
+	}
+}
+
+// Replay 把死信队列中的消息重新投递回原topic,由JobWorker重新处理
+// 连续idle时间内没有新消息时认为死信队列已经消费完,返回重放的消息条数
+func Replay(ctx context.Context, cfg *conf.Kafka, idle time.Duration, logger log.Logger) (int, error) {
+	l := log.NewHelper(logger)
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: cfg.GetBrokers(),
+		GroupID: cfg.GetGroupId() + "-dlq-replay",
+		Topic:   cfg.GetDlqTopic(),
+	})
+	defer reader.Close()
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(cfg.GetBrokers()...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer writer.Close()
+
+	n := 0
+	for {
+		fctx, cancel := context.WithTimeout(ctx, idle)
+		m, err := reader.FetchMessage(fctx)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		dl := new(DeadLetter)
+		if err := json.Unmarshal(m.Value, dl); err != nil {
+			// 不是本任务写入的死信,跳过
+			l.Errorf("unmarshal dead letter at offset %d failed, skip, err:%v", m.Offset, err)
+		} else {
+			// 投递回原topic,原topic为空时使用配置的topic
+			topic := dl.Topic
+			if topic == "" {
+				topic = cfg.GetTopic()
+			}
+			if err := writer.WriteMessages(ctx, kafka.Message{
+				Topic: topic,
+				Key:   []byte(dl.Key),
+				Value: []byte(dl.Payload),
+			}); err != nil {
+				return n, err
+			}
+			l.Infof("replayed message %v/%v/%v, reason:%s", dl.Topic, dl.Partition, dl.Offset, dl.Reason)
+			n++
+		}
+		// 重新投递成功后再提交死信队列的offset
+		if err := reader.CommitMessages(ctx, m); err != nil {
+			return n, err
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package job
+
+// 需要同步到ES的表
+const (
+	tableReview = "review_info"
+	tableReply  = "review_reply_info"
+	tableAppeal = "review_appeal_info"
+	tableAppend = "review_append_info"
+)
+
+// 回复、申诉和追评在评价文档中的字段名
+const (
+	fieldReply  = "reply"
+	fieldAppeal = "appeal"
+	fieldAppend = "append"
+)
+
+// document 把一行数据变更转换为对评价文档的操作
+// review_info的数据作为文档本身;review_reply_info、review_appeal_info和review_append_info的数据合并到文档的reply、appeal和append字段中
+// 不同表的消息可能乱序到达,所以都使用局部更新,不会覆盖掉其他表写入的字段
+// 只有review_info可以新建文档;子记录只更新已存在的文档,避免生成只有子记录的残缺文档;先于评价到达的子记录被丢弃,对账只比较status/version/has_reply,需要时通过reindex补齐
+// 不需要同步的表或不支持的消息类型返回false
+func document(table, typ string, d map[string]interface{}) (string, map[string]interface{}, bool) {
+	if typ != "INSERT" && typ != "UPDATE" && typ != "DELETE" {
+		return "", nil, false
+	}
+	// 物理删除、或者逻辑删除(delete_at不为空)的记录
+	deleted := typ == "DELETE" || isTombstone(d)
+	switch table {
+	case tableReview:
+		if deleted {
+			// 评价被删除,整个文档(包括回复、申诉和追评)一起删除
+			return opDelete, nil, true
+		}
+		return opUpsert, typed(reviewFields, d), true
+	case tableReply:
+		// 是否有回复以review_info.has_reply为准,这里只维护回复的内容
+		return opUpdate, map[string]interface{}{fieldReply: child(replyFields, d, deleted)}, true
+	case tableAppeal:
+		return opUpdate, map[string]interface{}{fieldAppeal: child(appealFields, d, deleted)}, true
+	case tableAppend:
+		return opUpdate, map[string]interface{}{fieldAppend: child(appendFields, d, deleted)}, true
+	}
+	return "", nil, false
+}
+
+// child 合并到评价文档中的子记录,已删除的记录置为null
+func child(fields map[string]fieldKind, d map[string]interface{}, deleted bool) map[string]interface{} {
+	if deleted {
+		return nil
+	}
+	c := typed(fields, d)
+	// review_id即文档id,不需要重复保存
+	delete(c, "review_id")
+	delete(c, "delete_at")
+	return c
+}
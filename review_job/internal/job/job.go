@@ -3,4 +3,4 @@ package job
 import "github.com/google/wire"
 
 // ProviderSet is job providers.
-var ProviderSet = wire.NewSet(NewKafkaReader, NewESClient, NewJobWorker)
+var ProviderSet = wire.NewSet(NewKafkaReader, NewDLQWriter, NewESClient, NewJobWorker, NewRedis, NewDB, NewReconciler)
@@ -0,0 +1,196 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types/enums/dynamicmapping"
+)
+
+// 评价索引中字段的类型
+// canal把所有字段都序列化为字符串,写入ES之前按字段类型转换,_source中保存的是有类型的值,查询方可以直接反序列化
+type fieldKind int
+
+const (
+	kindID      fieldKind = iota // bigint的id,映射为keyword,只做精确匹配
+	kindInt                      // 分数、状态等整数
+	kindDate                     // 时间,统一转换为RFC3339格式
+	kindText                     // 需要分词检索的文本
+	kindKeyword                  // 不分词的字符串
+	kindStored                   // 只保存不检索的内容,例如json
+)
+
+// ES中的时间格式,同时兼容canal的时间格式
+const dateFormat = "strict_date_optional_time||yyyy-MM-dd HH:mm:ss"
+
+// content等文本字段默认使用的分词器,安装了IK插件时可以配置为ik_max_word
+const defaultAnalyzer = "cjk"
+
+var reviewFields = map[string]fieldKind{
+	"id":              kindID,
+	"create_by":       kindKeyword,
+	"update_by":       kindKeyword,
+	"create_at":       kindDate,
+	"update_at":       kindDate,
+	"delete_at":       kindDate,
+	"version":         kindInt,
+	"review_id":       kindID,
+	"content":         kindText,
+	"score":           kindInt,
+	"service_score":   kindInt,
+	"express_score":   kindInt,
+	"has_media":       kindInt,
+	"order_id":        kindID,
+	"sku_id":          kindID,
+	"spu_id":          kindID,
+	"store_id":        kindID,
+	"user_id":         kindID,
+	"anonymous":       kindInt,
+	"tags":            kindStored,
+	"pic_info":        kindStored,
+	"video_info":      kindStored,
+	"status":          kindInt,
+	"is_default":      kindInt,
+	"has_reply":       kindInt,
+	"op_reason":       kindText,
+	"op_remarks":      kindText,
+	"op_user":         kindKeyword,
+	"goods_snapshoot": kindStored,
+	"ext_json":        kindStored,
+	"ctrl_json":       kindStored,
+}
+
+var replyFields = map[string]fieldKind{
+	"id":         kindID,
+	"create_by":  kindKeyword,
+	"update_by":  kindKeyword,
+	"create_at":  kindDate,
+	"update_at":  kindDate,
+	"version":    kindInt,
+	"reply_id":   kindID,
+	"store_id":   kindID,
+	"content":    kindText,
+	"pic_info":   kindStored,
+	"video_info": kindStored,
+	"ext_json":   kindStored,
+	"ctrl_json":  kindStored,
+}
+
+var appealFields = map[string]fieldKind{
+	"id":         kindID,
+	"create_by":  kindKeyword,
+	"update_by":  kindKeyword,
+	"create_at":  kindDate,
+	"update_at":  kindDate,
+	"version":    kindInt,
+	"appeal_id":  kindID,
+	"store_id":   kindID,
+	"status":     kindInt,
+	"reason":     kindText,
+	"content":    kindText,
+	"pic_info":   kindStored,
+	"video_info": kindStored,
+	"op_remarks": kindText,
+	"op_user":    kindKeyword,
+	"ext_json":   kindStored,
+	"ctrl_json":  kindStored,
+}
+
+var appendFields = map[string]fieldKind{
+	"id":         kindID,
+	"create_by":  kindKeyword,
+	"update_by":  kindKeyword,
+	"create_at":  kindDate,
+	"update_at":  kindDate,
+	"version":    kindInt,
+	"append_id":  kindID,
+	"user_id":    kindID,
+	"store_id":   kindID,
+	"status":     kindInt,
+	"content":    kindText,
+	"pic_info":   kindStored,
+	"video_info": kindStored,
+	"op_reason":  kindText,
+	"op_remarks": kindText,
+	"op_user":    kindKeyword,
+	"ext_json":   kindStored,
+	"ctrl_json":  kindStored,
+}
+
+// PutTemplate 创建(或覆盖)评价索引的模板,对别名本身以及reindex创建的<index>_<时间>索引生效
+// 模板只影响之后新建的索引,已经存在的索引需要通过reindex重建才能使用新的mapping
+func (e *ESClient) PutTemplate(ctx context.Context) error {
+	dynamic := dynamicmapping.False // 表中新增的字段先只保存不索引,需要检索时加到上面的字段列表中
+	props := properties(reviewFields, e.analyzer)
+	props[fieldReply] = &types.ObjectProperty{Properties: properties(replyFields, e.analyzer)}
+	props[fieldAppeal] = &types.ObjectProperty{Properties: properties(appealFields, e.analyzer)}
+	props[fieldAppend] = &types.ObjectProperty{Properties: properties(appendFields, e.analyzer)}
+	_, err := e.client.Indices.PutIndexTemplate(e.index).
+		IndexPatterns(e.index, e.index+"_*").
+		Template(&types.IndexTemplateMapping{
+			Mappings: &types.TypeMapping{
+				Dynamic:    &dynamic,
+				Properties: props,
+			},
+		}).Do(ctx)
+	if err != nil {
+		return fmt.Errorf("put index template %s failed, err:%w", e.index, err)
+	}
+	return nil
+}
+
+func properties(fields map[string]fieldKind, analyzer string) map[string]types.Property {
+	no := false
+	format := dateFormat
+	m := make(map[string]types.Property, len(fields))
+	for name, kind := range fields {
+		switch kind {
+		case kindID, kindKeyword:
+			m[name] = types.NewKeywordProperty()
+		case kindInt:
+			m[name] = types.NewIntegerNumberProperty()
+		case kindDate:
+			p := types.NewDateProperty()
+			p.Format = &format
+			m[name] = p
+		case kindText:
+			p := types.NewTextProperty()
+			p.Analyzer = &analyzer
+			m[name] = p
+		case kindStored:
+			p := types.NewKeywordProperty()
+			p.Index = &no
+			p.DocValues = &no
+			m[name] = p
+		}
+	}
+	return m
+}
+
+// typed 按字段类型转换canal的字符串值,转换失败或者不认识的字段保持原样
+func typed(fields map[string]fieldKind, d map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(d))
+	for k, v := range d {
+		m[k] = v
+		s, ok := v.(string)
+		kind, known := fields[k]
+		if !ok || !known {
+			continue
+		}
+		switch kind {
+		case kindID, kindInt:
+			if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+				m[k] = n
+			}
+		case kindDate:
+			// canal的时间不带时区,和MySQL连接使用的时区(loc=Local)保持一致
+			if t, err := time.ParseInLocation(time.DateTime, s, time.Local); err == nil {
+				m[k] = t.Format(time.RFC3339)
+			}
+		}
+	}
+	return m
+}
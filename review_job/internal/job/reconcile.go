@@ -0,0 +1,232 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"review_job/internal/conf"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ReconcileStats 对账结果的计数
+type ReconcileStats struct {
+	Checked  int64 `json:"checked"`  // 检查的评价数
+	Missing  int64 `json:"missing"`  // MySQL中有、ES中没有的文档
+	Stale    int64 `json:"stale"`    // status/version/has_reply和MySQL不一致的文档
+	Orphan   int64 `json:"orphan"`   // MySQL中已删除、ES中还存在的文档
+	Repaired int64 `json:"repaired"` // 修复成功的文档
+	Failed   int64 `json:"failed"`   // 对账或修复失败的次数
+}
+
+func (s *ReconcileStats) add(o ReconcileStats) {
+	s.Checked += o.Checked
+	s.Missing += o.Missing
+	s.Stale += o.Stale
+	s.Orphan += o.Orphan
+	s.Repaired += o.Repaired
+	s.Failed += o.Failed
+}
+
+// Reconciler 定期对比MySQL和ES中的评价,修复不一致的文档,实现Transport.Server接口
+// 每一轮检查上一轮之后update_at有变化的评价,canal链路中断时missing/stale会持续增长,可以据此报警
+// 物理删除的评价不在检查范围内(业务上只做逻辑删除),需要时通过reindex重建
+type Reconciler struct {
+	r        *reindexer
+	interval time.Duration
+	window   time.Duration
+	delay    time.Duration
+	log      *log.Helper
+
+	mu    sync.Mutex
+	from  time.Time      // 下一轮从这个时间开始检查
+	last  ReconcileStats // 最近一轮的结果
+	total ReconcileStats // 启动以来的累计结果
+	at    time.Time      // 最近一轮的完成时间
+}
+
+// NewDB 对账使用的MySQL连接
+func NewDB(cfg *conf.Data) (*sql.DB, func(), error) {
+	db, err := sql.Open(cfg.GetDatabase().GetDriver(), cfg.GetDatabase().GetSource())
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, func() { db.Close() }, nil
+}
+
+func NewReconciler(db *sql.DB, e *ESClient, cfg *conf.Reconcile, logger log.Logger) *Reconciler {
+	l := log.NewHelper(logger)
+	rc := &Reconciler{
+		r: &reindexer{
+			db:        db,
+			jw:        JobWorker{esClient: e, log: l},
+			batchSize: e.bulkActions,
+		},
+		interval: cfg.GetInterval().AsDuration(),
+		window:   cfg.GetWindow().AsDuration(),
+		delay:    cfg.GetDelay().AsDuration(),
+		log:      l,
+	}
+	if rc.interval <= 0 {
+		rc.interval = 5 * time.Minute
+	}
+	if rc.window <= 0 {
+		rc.window = time.Hour
+	}
+	if rc.delay <= 0 {
+		rc.delay = time.Minute
+	}
+	return rc
+}
+
+// Start kratos程序启动之后会调用的方法,ctx在程序退出时会被取消
+func (rc *Reconciler) Start(ctx context.Context) error {
+	rc.log.Debug("Reconciler start....")
+	ticker := time.NewTicker(rc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		rc.round(ctx)
+	}
+}
+
+// Stop kratos结束之后会调用的
+func (rc *Reconciler) Stop(context.Context) error {
+	rc.log.Debug("Reconciler stop....")
+	return nil
+}
+
+// ServeHTTP 以json格式返回对账的计数,供监控采集
+func (rc *Reconciler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	rc.mu.Lock()
+	body, _ := json.Marshal(map[string]interface{}{
+		"last":  rc.last,
+		"total": rc.total,
+		"at":    rc.at,
+	})
+	rc.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// round 检查[from, to)之间有变更的评价
+// to往前留出delay,给canal同步留出时间,避免把正常的同步延迟当作不一致
+func (rc *Reconciler) round(ctx context.Context) {
+	to := time.Now().Add(-rc.delay)
+	from := rc.from
+	if from.IsZero() {
+		from = to.Add(-rc.window)
+	}
+	var s ReconcileStats
+	var lastID int64
+	for {
+		rows, err := rc.r.query(ctx, "SELECT * FROM review_info WHERE update_at >= ? AND update_at < ? AND id > ? ORDER BY id LIMIT ?", from, to, lastID, rc.r.batchSize)
+		if err != nil {
+			rc.log.Errorf("query reviews changed in [%v, %v) failed, err:%v", from, to, err)
+			s.Failed++
+			break
+		}
+		if len(rows) == 0 {
+			// 这个窗口检查完了,下一轮从to开始;失败时下一轮重新检查整个窗口
+			rc.from = to
+			break
+		}
+		if err := rc.check(ctx, rows, &s); err != nil {
+			rc.log.Errorf("reconcile reviews failed, err:%v", err)
+			s.Failed++
+			break
+		}
+		id, _ := rows[len(rows)-1]["id"].(string)
+		if lastID, err = strconv.ParseInt(id, 10, 64); err != nil {
+			rc.log.Errorf("invalid id:%v", id)
+			s.Failed++
+			break
+		}
+	}
+	if s.Missing+s.Stale+s.Orphan > 0 {
+		rc.log.Warnf("reconcile [%v, %v): %+v", from, to, s)
+	} else {
+		rc.log.Debugf("reconcile [%v, %v): %+v", from, to, s)
+	}
+	rc.mu.Lock()
+	rc.last = s
+	rc.total.add(s)
+	rc.at = time.Now()
+	rc.mu.Unlock()
+}
+
+// esState ES文档中参与对账的字段
+type esState struct {
+	Status   int64 `json:"status"`
+	Version  int64 `json:"version"`
+	HasReply int64 `json:"has_reply"`
+}
+
+// check 对比一批评价和ES中的文档,修复不一致的文档
+func (rc *Reconciler) check(ctx context.Context, rows []map[string]interface{}, s *ReconcileStats) error {
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		id, _ := row["review_id"].(string)
+		ids = append(ids, id)
+	}
+	e := rc.r.jw.esClient
+	resp, err := e.client.Mget().Index(e.index).Ids(ids...).SourceIncludes_("status", "version", "has_reply").Do(ctx)
+	if err != nil {
+		return err
+	}
+	docs := make(map[string]json.RawMessage, len(resp.Docs))
+	for _, item := range resp.Docs {
+		if doc, ok := item.(*types.GetResult); ok && doc.Found {
+			docs[doc.Id_] = doc.Source_
+		}
+	}
+
+	var repair []map[string]interface{}
+	orphans := make(map[string]bool)
+	for i, row := range rows {
+		id := ids[i]
+		source, found := docs[id]
+		s.Checked++
+		switch {
+		case isTombstone(row):
+			if found {
+				s.Orphan++
+				orphans[id] = true
+			}
+		case !found:
+			s.Missing++
+			repair = append(repair, row)
+		case !consistent(row, source):
+			s.Stale++
+			repair = append(repair, row)
+		}
+	}
+	if len(repair) == 0 && len(orphans) == 0 {
+		return nil
+	}
+	if err := rc.r.write(ctx, repair, orphans); err != nil {
+		return err
+	}
+	s.Repaired += int64(len(repair) + len(orphans))
+	return nil
+}
+
+// consistent 判断ES文档中的status/version/has_reply是否和MySQL一致
+// 旧格式(字符串)的文档反序列化会失败,同样视为不一致
+func consistent(row map[string]interface{}, source json.RawMessage) bool {
+	var doc esState
+	if err := json.Unmarshal(source, &doc); err != nil {
+		return false
+	}
+	want := typed(reviewFields, row)
+	return want["status"] == doc.Status && want["version"] == doc.Version && want["has_reply"] == doc.HasReply
+}
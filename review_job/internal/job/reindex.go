@@ -0,0 +1,315 @@
+package job
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"review_job/internal/conf"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/go-elasticsearch/v8/typedapi/types"
+	"github.com/go-kratos/kratos/v2/log"
+	_ "github.com/go-sql-driver/mysql"
+)
+
+// reindexer 从MySQL全量重建评价索引
+type reindexer struct {
+	db        *sql.DB
+	jw        JobWorker // 复用JobWorker的bulk写入和重试逻辑
+	batchSize int
+}
+
+// Reindex 从MySQL全量重建ES中的评价索引,返回新索引的名字
+// 配置中的index作为别名,数据写入新的带版本号的索引(<index>_<时间>),写完之后原子地把别名切换到新索引,
+// review-service和JobWorker都通过别名访问,不会读到写了一半的索引;旧的带版本号的索引保留下来,需要时可以切回去
+// 第一次reindex时<index>可能是JobWorker自动创建的真实索引,切换别名需要删除它,dropIndex为false时拒绝切换
+// 全量写入期间MySQL中发生的变更,在切换别名前后各补写一次
+func Reindex(ctx context.Context, db *conf.Data_Database, cfg *conf.ES, dropIndex bool, logger log.Logger) (string, error) {
+	l := log.NewHelper(logger)
+	conn, err := sql.Open(db.GetDriver(), db.GetSource())
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	e, err := NewESClient(cfg)
+	if err != nil {
+		return "", err
+	}
+	alias := e.index
+	index := fmt.Sprintf("%s_%s", alias, time.Now().Format("20060102150405"))
+	// 新索引只给reindex使用,JobWorker仍然写别名
+	w := *e
+	w.index = index
+	r := &reindexer{
+		db:        conn,
+		jw:        JobWorker{esClient: &w, log: l},
+		batchSize: e.bulkActions,
+	}
+
+	// 需要删除真实索引又没有确认时,在全量写入之前就退出
+	if !dropIndex {
+		if concrete, err := isIndex(ctx, e, alias); err != nil {
+			return "", err
+		} else if concrete {
+			return "", fmt.Errorf("%s is an index rather than an alias, it will be deleted when switching, confirm with -drop-index", alias)
+		}
+	}
+	// 新索引按模板中的mapping创建
+	if err := e.PutTemplate(ctx); err != nil {
+		return "", err
+	}
+	if _, err := e.client.Indices.Create(index).Do(ctx); err != nil {
+		return "", fmt.Errorf("create index %s failed, err:%w", index, err)
+	}
+	// update_at精确到秒,往前多取一秒,宁可重复写也不能漏掉
+	start := time.Now().Add(-time.Second)
+	n, err := r.all(ctx)
+	if err != nil {
+		return "", err
+	}
+	l.Infof("indexed %d reviews into %s", n, index)
+	// 补写全量期间的变更,缩短切换后新索引落后的时间
+	since := time.Now().Add(-time.Second)
+	if n, err = r.changed(ctx, start); err != nil {
+		return "", err
+	}
+	l.Infof("caught up %d reviews changed since %v", n, start)
+	if _, err := e.client.Indices.Refresh().Index(index).Do(ctx); err != nil {
+		return "", fmt.Errorf("refresh index %s failed, err:%w", index, err)
+	}
+	old, err := swapAlias(ctx, e, alias, index, dropIndex)
+	if err != nil {
+		return index, err
+	}
+	l.Infof("alias %s switched from %v to %s", alias, old, index)
+	// 切换之前JobWorker写的还是旧索引,再补写一次
+	if n, err = r.changed(ctx, since); err != nil {
+		return index, err
+	}
+	l.Infof("caught up %d reviews changed since %v", n, since)
+	return index, nil
+}
+
+// swapAlias 在一个请求中把别名从旧索引移到新索引,返回旧索引
+// 第一次reindex时alias可能是一个真实的索引(由JobWorker写入时自动创建),dropIndex为true时把它删除,并在同一个请求中建立同名的别名
+// 全量写入期间JobWorker也可能自动创建它,所以切换时要再检查一次
+func swapAlias(ctx context.Context, e *ESClient, alias, index string, dropIndex bool) ([]string, error) {
+	exists, err := e.client.Indices.Exists(alias).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var old []string
+	var actions []types.IndicesAction
+	if exists {
+		indices, err := e.client.Indices.Get(alias).Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for name := range indices {
+			name := name
+			old = append(old, name)
+			if name == alias {
+				if !dropIndex {
+					return nil, fmt.Errorf("%s is an index rather than an alias, it will be deleted when switching, confirm with -drop-index; %s is kept", alias, index)
+				}
+				actions = append(actions, types.IndicesAction{RemoveIndex: &types.RemoveIndexAction{Index: &name}})
+			} else {
+				actions = append(actions, types.IndicesAction{Remove: &types.RemoveAction{Index: &name, Alias: &alias}})
+			}
+		}
+	}
+	actions = append(actions, types.IndicesAction{Add: &types.AddAction{Index: &index, Alias: &alias}})
+	if _, err := e.client.Indices.UpdateAliases().Actions(actions...).Do(ctx); err != nil {
+		return nil, fmt.Errorf("switch alias %s to %s failed, err:%w", alias, index, err)
+	}
+	return old, nil
+}
+
+// all 按id顺序分批写入所有未删除的评价,返回写入的条数
+func (r *reindexer) all(ctx context.Context) (int, error) {
+	var lastID int64
+	n := 0
+	for {
+		reviews, err := r.query(ctx, "SELECT * FROM review_info WHERE id > ? AND delete_at IS NULL ORDER BY id LIMIT ?", lastID, r.batchSize)
+		if err != nil {
+			return n, err
+		}
+		if len(reviews) == 0 {
+			return n, nil
+		}
+		if err := r.write(ctx, reviews, nil); err != nil {
+			return n, err
+		}
+		n += len(reviews)
+		id, _ := reviews[len(reviews)-1]["id"].(string)
+		if lastID, err = strconv.ParseInt(id, 10, 64); err != nil {
+			return n, fmt.Errorf("invalid id:%v", id)
+		}
+	}
+}
+
+// changed 重新写入since之后评价、回复、申诉或追评有变更的评价,返回写入的条数
+func (r *reindexer) changed(ctx context.Context, since time.Time) (int, error) {
+	rows, err := r.query(ctx, `SELECT review_id FROM review_info WHERE update_at >= ?
+UNION SELECT review_id FROM review_reply_info WHERE update_at >= ?
+UNION SELECT review_id FROM review_appeal_info WHERE update_at >= ?
+UNION SELECT review_id FROM review_append_info WHERE update_at >= ?`, since, since, since, since)
+	if err != nil {
+		return 0, err
+	}
+	ids := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row["review_id"])
+	}
+	for i := 0; i < len(ids); i += r.batchSize {
+		batch := ids[i:min(i+r.batchSize, len(ids))]
+		reviews, err := r.query(ctx, "SELECT * FROM review_info WHERE delete_at IS NULL AND review_id IN ("+placeholders(len(batch))+")", batch...)
+		if err != nil {
+			return i, err
+		}
+		// 查不到的评价已经被删除了
+		deleted := make(map[string]bool, len(batch))
+		for _, id := range batch {
+			deleted[id.(string)] = true
+		}
+		for _, review := range reviews {
+			delete(deleted, review["review_id"].(string))
+		}
+		if err := r.write(ctx, reviews, deleted); err != nil {
+			return i, err
+		}
+	}
+	return len(ids), nil
+}
+
+// write 把评价连同回复、申诉和追评合成文档写入新索引,并删除deleted中的评价
+// 有任何文档写入失败都返回错误,不切换别名
+func (r *reindexer) write(ctx context.Context, reviews []map[string]interface{}, deleted map[string]bool) error {
+	actions := make([]bulkAction, 0, len(reviews)+len(deleted))
+	for id := range deleted {
+		actions = append(actions, bulkAction{op: opDelete, id: id})
+	}
+	if len(reviews) > 0 {
+		ids := make([]interface{}, 0, len(reviews))
+		for _, review := range reviews {
+			ids = append(ids, review["review_id"])
+		}
+		replies, err := r.children(ctx, tableReply, replyFields, ids)
+		if err != nil {
+			return err
+		}
+		appeals, err := r.children(ctx, tableAppeal, appealFields, ids)
+		if err != nil {
+			return err
+		}
+		appends, err := r.children(ctx, tableAppend, appendFields, ids)
+		if err != nil {
+			return err
+		}
+		for _, review := range reviews {
+			id := review["review_id"].(string)
+			// 没有回复、申诉或追评时显式置为null,覆盖掉新索引中可能已经写入的旧数据
+			doc := typed(reviewFields, review)
+			doc[fieldReply] = replies[id]
+			doc[fieldAppeal] = appeals[id]
+			doc[fieldAppend] = appends[id]
+			actions = append(actions, bulkAction{op: opUpsert, id: id, doc: doc})
+		}
+	}
+	if len(actions) == 0 {
+		return nil
+	}
+	failures := make(map[int][]string)
+	_, _, err := r.jw.write(ctx, actions, failures, false)
+	if err != nil {
+		return err
+	}
+	// 所有操作都属于同一批,有任何一条失败就返回错误
+	var reasons []string
+	for _, r := range failures {
+		reasons = append(reasons, r...)
+	}
+	if len(reasons) > 0 {
+		return errors.New(strings.Join(reasons, "; "))
+	}
+	return nil
+}
+
+// isIndex 判断name是否是一个真实的索引(而不是别名),不存在时返回false
+func isIndex(ctx context.Context, e *ESClient, name string) (bool, error) {
+	exists, err := e.client.Indices.Exists(name).Do(ctx)
+	if err != nil || !exists {
+		return false, err
+	}
+	indices, err := e.client.Indices.Get(name).Do(ctx)
+	if err != nil {
+		return false, err
+	}
+	_, ok := indices[name]
+	return ok, nil
+}
+
+// children 查询评价的回复、申诉或追评,返回review_id -> 合并到文档中的子记录
+func (r *reindexer) children(ctx context.Context, table string, fields map[string]fieldKind, ids []interface{}) (map[string]map[string]interface{}, error) {
+	rows, err := r.query(ctx, "SELECT * FROM "+table+" WHERE delete_at IS NULL AND review_id IN ("+placeholders(len(ids))+")", ids...)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]map[string]interface{}, len(rows))
+	for _, row := range rows {
+		m[row["review_id"].(string)] = child(fields, row, false)
+	}
+	return m, nil
+}
+
+// query 执行查询,把每一行转换为和canal消息中相同格式的数据
+func (r *reindexer) query(ctx context.Context, query string, args ...interface{}) ([]map[string]interface{}, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var list []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]interface{}, len(cols))
+		for i, col := range cols {
+			row[col] = canalValue(values[i])
+		}
+		list = append(list, row)
+	}
+	return list, rows.Err()
+}
+
+// canalValue canal把所有字段都序列化为字符串,NULL序列化为null,时间格式为2006-01-02 15:04:05
+// 先转换为和canal相同的格式,再和JobWorker一样按字段类型转换,保证两边写入的文档格式一致
+func canalValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.DateTime)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
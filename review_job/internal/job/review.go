@@ -6,9 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"review_job/internal/conf"
+	"time"
 
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redis/redis"
 	"github.com/segmentio/kafka-go"
 )
 
@@ -17,23 +19,48 @@ import (
 // JOB自定义执行JOB结构体,实现Transport.Server接口
 type JobWorker struct {
 	kafkaReader *kafka.Reader
+	dlqWriter   *kafka.Writer // 死信队列
 	esClient    *ESClient
+	rdb         *redis.Client // 写入ES之后让商家的评价缓存失效,为nil时不处理缓存
 	log         *log.Helper
 }
 
 type ESClient struct {
 	client *elasticsearch.TypedClient
 	index  string
+
+	// 写ES失败时的重试策略
+	maxRetries int
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	// bulk批量写入的限制,任意一个条件满足就写一次ES
+	bulkActions   int
+	bulkBytes     int
+	flushInterval time.Duration
+
+	analyzer string // 文本字段的分词器
 }
 
-func NewJobWorker(k *kafka.Reader, e *ESClient, logger log.Logger) *JobWorker {
+func NewJobWorker(k *kafka.Reader, w *kafka.Writer, e *ESClient, rdb *redis.Client, logger log.Logger) *JobWorker {
 	return &JobWorker{
 		kafkaReader: k,
+		dlqWriter:   w,
 		esClient:    e,
+		rdb:         rdb,
 		log:         log.NewHelper(logger),
 	}
 }
 
+// NewRedis review-service缓存评价列表使用的redis
+func NewRedis(cfg *conf.Data) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:         cfg.GetRedis().GetAddr(),
+		ReadTimeout:  cfg.GetRedis().GetReadTimeout().AsDuration(),
+		WriteTimeout: cfg.GetRedis().GetWriteTimeout().AsDuration(),
+	})
+}
+
 func NewKafkaReader(cfg *conf.Kafka) *kafka.Reader {
 	return kafka.NewReader(kafka.ReaderConfig{
 		Brokers: cfg.GetBrokers(),
@@ -42,6 +69,16 @@ func NewKafkaReader(cfg *conf.Kafka) *kafka.Reader {
 	})
 }
 
+// NewDLQWriter 死信队列的writer,消息key与原消息保持一致
+func NewDLQWriter(cfg *conf.Kafka) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(cfg.GetBrokers()...),
+		Topic:        cfg.GetDlqTopic(),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+}
+
 func NewESClient(cfg *conf.ES) (*ESClient, error) {
 	client, err := elasticsearch.NewTypedClient(elasticsearch.Config{
 		Addresses: cfg.GetAddresses(),
@@ -49,10 +86,42 @@ func NewESClient(cfg *conf.ES) (*ESClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &ESClient{
-		client: client,
-		index:  cfg.GetIndex(),
-	}, nil
+	e := &ESClient{
+		client:     client,
+		index:      cfg.GetIndex(),
+		maxRetries: int(cfg.GetMaxRetries()),
+		backoff:    cfg.GetRetryBackoff().AsDuration(),
+		maxBackoff: cfg.GetMaxBackoff().AsDuration(),
+
+		bulkActions:   int(cfg.GetBulkActions()),
+		bulkBytes:     int(cfg.GetBulkBytes()),
+		flushInterval: cfg.GetFlushInterval().AsDuration(),
+
+		analyzer: cfg.GetAnalyzer(),
+	}
+	// 没有配置时使用默认的重试策略
+	if e.maxRetries <= 0 {
+		e.maxRetries = 3
+	}
+	if e.backoff <= 0 {
+		e.backoff = 100 * time.Millisecond
+	}
+	if e.maxBackoff <= 0 {
+		e.maxBackoff = 5 * time.Second
+	}
+	if e.bulkActions <= 0 {
+		e.bulkActions = 500
+	}
+	if e.bulkBytes <= 0 {
+		e.bulkBytes = 5 << 20
+	}
+	if e.flushInterval <= 0 {
+		e.flushInterval = time.Second
+	}
+	if e.analyzer == "" {
+		e.analyzer = defaultAnalyzer
+	}
+	return e, nil
 }
 
 // KAFKA 从Canal中收到的消息
@@ -61,6 +130,7 @@ type Msg struct {
 	Database string                   `json:"database"`
 	Table    string                   `json:"table"`
 	IsDDL    bool                     `json:"isDdl"`
+	SQL      string                   `json:"sql"`
 	Data     []map[string]interface{} `json:"data"`
 }
 
@@ -68,82 +138,132 @@ type Msg struct {
 // ctx 是kratos框架启动的时候传入的ctx，是带有退出取消的
 func (jw JobWorker) Start(ctx context.Context) error {
 	jw.log.Debug("JobWorker start....")
-	// 1. 从kafka中获取MySQL中的数据变更消息
-	// 接收消息
+	// 写入之前先确保索引模板存在,自动创建的索引才会使用正确的mapping
+	if err := jw.esClient.PutTemplate(ctx); err != nil {
+		return err
+	}
+	b := new(batch)
+	flushAt := time.Now().Add(jw.esClient.flushInterval)
 	for {
-		m, err := jw.kafkaReader.ReadMessage(ctx)
-		if errors.Is(err, context.Canceled) {
+		// 1. 从kafka中获取MySQL中的数据变更消息
+		// 使用FetchMessage,等这批数据写入ES之后再提交offset
+		fctx, cancel := context.WithDeadline(ctx, flushAt)
+		m, err := jw.kafkaReader.FetchMessage(fctx)
+		cancel()
+		if ctx.Err() != nil {
+			// 程序退出,未提交的消息下次启动后会重新消费
 			return nil
 		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			// 到了刷新时间,不满一批也写入ES
+			if err := jw.flush(ctx, b); err != nil {
+				return nil
+			}
+			flushAt = time.Now().Add(jw.esClient.flushInterval)
+			continue
+		}
 		if err != nil {
-			jw.log.Errorf("readMessage from kafka failed, err:%v", err)
-			break
+			// 读取失败(例如broker暂时不可用)不退出任务,等待一会儿再继续读取
+			jw.log.Errorf("fetchMessage from kafka failed, err:%v", err)
+			if err := sleep(ctx, jw.esClient.backoff); err != nil {
+				return nil
+			}
+			continue
 		}
 		// jw.log.Debugf("message at topic/partition/offset %v/%v/%v: %s = %s\n", m.Topic, m.Partition, m.Offset, string(m.Key), string(m.Value))
-		// 2. 将完整评价数据写入ES
-		fmt.Println("从KAFKA中获取的MSG的信息", string(m.Value))
-		fmt.Println("------")
-		msg := new(Msg)
-		if err := json.Unmarshal(m.Value, msg); err != nil {
-			jw.log.Errorf("unmarshal msg from kafka failed, err:%v", err)
-			continue
+		// 2. 把消息转换成ES的文档操作,攒够一批之后写入ES
+		jw.add(ctx, b, m)
+		if len(b.actions) >= jw.esClient.bulkActions || b.bytes >= jw.esClient.bulkBytes {
+			if err := jw.flush(ctx, b); err != nil {
+				return nil
+			}
+			flushAt = time.Now().Add(jw.esClient.flushInterval)
 		}
+	}
+}
 
-		// 补充！
-		// 实际的业务场景可能需要在这增加一个步骤：对数据做业务处理
-		// 例如：把两张表的数据合成一个文档写入ES
+// add 解析一条kafka消息,把其中每一行数据对应的文档操作加入批次
+func (jw JobWorker) add(ctx context.Context, b *batch, m kafka.Message) {
+	idx := b.addMessage(m)
+	msg := new(Msg)
+	if err := json.Unmarshal(m.Value, msg); err != nil {
+		// 消息格式错误,重试没有意义,直接进入死信队列
+		jw.deadLetter(ctx, m, fmt.Errorf("unmarshal msg from kafka failed, err:%w", err), 1)
+		return
+	}
+	// 表结构变更(DDL)不涉及文档,记录下来提醒检查ES的mapping即可
+	if msg.IsDDL {
+		jw.log.Warnf("schema change on %s.%s, skip, sql:%s", msg.Database, msg.Table, msg.SQL)
+		return
+	}
 
-		if msg.Type == "INSERT" {
-			// 往ES中新增文档
-			for idx := range msg.Data {
-				jw.indexDocument(msg.Data[idx])
-			}
-		} else {
-			// 往ES中更新文档
-			for idx := range msg.Data {
-				jw.updateDocument(msg.Data[idx])
-			}
+	// 评价、回复、申诉三张表的数据合成一个评价文档写入ES,文档id为review_id
+	// 先转换完所有行再加入批次:有一行不合法时整条消息进入死信队列,不会只写入其中一部分行
+	actions := make([]bulkAction, 0, len(msg.Data))
+	for _, d := range msg.Data {
+		reviewID, ok := d["review_id"].(string)
+		if !ok {
+			jw.deadLetter(ctx, m, fmt.Errorf("invalid review_id:%v", d["review_id"]), 1)
+			return
+		}
+		op, doc, ok := document(msg.Table, msg.Type, d)
+		if !ok {
+			jw.log.Warnf("unsupported canal message %s on %s.%s, skip", msg.Type, msg.Database, msg.Table)
+			return
 		}
+		storeID, _ := d["store_id"].(string)
+		spuID, _ := d["spu_id"].(string)
+		actions = append(actions, bulkAction{msg: idx, op: op, id: reviewID, store: storeID, spu: spuID, doc: doc})
 	}
+	b.actions = append(b.actions, actions...)
+}
 
-	return nil
+// isTombstone 判断记录是否已被逻辑删除
+// canal中为NULL的字段会被序列化为null
+func isTombstone(d map[string]interface{}) bool {
+	v, ok := d["delete_at"]
+	return ok && v != nil && v != ""
 }
 
-// Stop kratos结束之后会调用的
-func (jw JobWorker) Stop(context.Context) error {
-	jw.log.Debug("JobWorker stop....")
-	// 程序退出前关闭Reader
-	return jw.kafkaReader.Close()
+// retry 执行fn,失败时按指数退避重试,最多重试maxRetries次
+// 返回总共尝试的次数以及最后一次的错误
+func (jw JobWorker) retry(ctx context.Context, fn func(context.Context) error) (int, error) {
+	backoff := jw.esClient.backoff
+	attempts := 0
+	for {
+		attempts++
+		err := fn(ctx)
+		if err == nil || attempts > jw.esClient.maxRetries {
+			return attempts, err
+		}
+		jw.log.Warnf("attempt %d failed, retry after %v, err:%v", attempts, backoff, err)
+		if err := sleep(ctx, backoff); err != nil {
+			return attempts, err
+		}
+		if backoff *= 2; backoff > jw.esClient.maxBackoff {
+			backoff = jw.esClient.maxBackoff
+		}
+	}
 }
 
-// indexDocument 索引文档
-func (jw JobWorker) indexDocument(d map[string]interface{}) {
-	reviewID := d["review_id"].(string)
-	fmt.Println(reviewID)
-	fmt.Println(d)
-	// 添加文档
-	resp, err := jw.esClient.client.Index(jw.esClient.index).
-		Id(reviewID).
-		Document(d).
-		Do(context.Background())
-	if err != nil {
-		jw.log.Errorf("indexing document failed, err:%v\n", err)
-		return
+// sleep 等待d,ctx取消时提前返回
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
 	}
-	jw.log.Debugf("result:%#v\n", resp.Result)
 }
 
-// updateDocument 更新文档
-func (jw JobWorker) updateDocument(d map[string]interface{}) {
-	fmt.Println(d)
-	reviewID := d["review_id"].(string)
-	fmt.Println(reviewID)
-	resp, err := jw.esClient.client.Update(jw.esClient.index, reviewID).
-		Doc(d). // 使用结构体变量更新
-		Do(context.Background())
-	if err != nil {
-		jw.log.Errorf("update document failed, err:%v\n", err)
-		return
+// Stop kratos结束之后会调用的
+func (jw JobWorker) Stop(context.Context) error {
+	jw.log.Debug("JobWorker stop....")
+	// 程序退出前关闭Reader和死信队列的Writer
+	if err := jw.dlqWriter.Close(); err != nil {
+		jw.log.Errorf("close dlq writer failed, err:%v", err)
 	}
-	jw.log.Debugf("result:%v\n", resp.Result)
+	return jw.kafkaReader.Close()
 }